@@ -91,6 +91,9 @@ var flagRules = []linter.FlagRule{
 			"destination-cluster",
 			"destination-api-key",
 			"destination-api-secret",
+			"destination-schema-registry-endpoint",
+			"destination-schema-registry-api-key",
+			"destination-schema-registry-api-secret",
 			"enable-systest-events",
 			"max-partition-memory-bytes",
 			"message-send-max-retries",
@@ -117,15 +120,22 @@ var flagRules = []linter.FlagRule{
 			"destination-bootstrap-server",
 			"destination-api-key",
 			"destination-api-secret",
+			"destination-schema-registry-endpoint",
+			"destination-schema-registry-api-key",
+			"destination-schema-registry-api-secret",
 			"enable-systest-events",
+			"fail-on-timeout",
 			"log-exclude-rows",
+			"max-in-flight",
 			"if-not-exists",
+			"key-schema-id",
 			"local-secrets-file",
 			"max-block-ms",
 			"max-memory-bytes",
 			"max-partition-memory-bytes",
 			"message-send-max-retries",
 			"metadata-expiry-ms",
+			"no-schema-registry",
 			"remote-secrets-file",
 			"request-required-acks",
 			"request-timeout-ms",
@@ -201,6 +211,7 @@ var vocabWords = []string{
 	"avro",
 	"aws",
 	"backoff",
+	"base64",
 	"cku",
 	"cli",
 	"codec",
@@ -212,6 +223,7 @@ var vocabWords = []string{
 	"decrypt",
 	"deserializer",
 	"deserializers",
+	"dir",
 	"env",
 	"eu",
 	"failover",
@@ -222,6 +234,7 @@ var vocabWords = []string{
 	"hostname",
 	"iam",
 	"json",
+	"jsonl",
 	"jsonschema",
 	"jwks",
 	"kafka",
@@ -256,6 +269,7 @@ var vocabWords = []string{
 	"url",
 	"uri",
 	"us",
+	"utf",
 	"v2",
 	"vpc",
 	"whitelist",
@@ -0,0 +1,259 @@
+package connect
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/antihax/optional"
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+	mds "github.com/confluentinc/mds-sdk-go-public/mdsv1"
+	"github.com/spf13/cobra"
+
+	pauth "github.com/confluentinc/cli/internal/pkg/auth"
+	"github.com/confluentinc/cli/internal/pkg/cluster"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+)
+
+// onPremClient is a client of the REST API of a self-managed Connect cluster, authenticated with the MDS token.
+type onPremClient struct {
+	url        string
+	authToken  string
+	userAgent  string
+	httpClient *http.Client
+}
+
+type onPremConnectorRequest struct {
+	Name   string            `json:"name"`
+	Config map[string]string `json:"config"`
+}
+
+// onPremConnectorStatus is the status of a connector as reported by a self-managed Connect cluster,
+// whose tasks report their errors in a trace rather than a message.
+type onPremConnectorStatus struct {
+	Name      string                                               `json:"name"`
+	Type      string                                               `json:"type"`
+	Connector connectv1.ConnectV1ConnectorExpansionStatusConnector `json:"connector"`
+	Tasks     []onPremTaskStatus                                   `json:"tasks"`
+}
+
+type onPremTaskStatus struct {
+	Id       int32  `json:"id"`
+	State    string `json:"state"`
+	WorkerId string `json:"worker_id"`
+	Trace    string `json:"trace,omitempty"`
+}
+
+func (s *onPremConnectorStatus) toExpansionStatus() *connectv1.ConnectV1ConnectorExpansionStatus {
+	tasks := make([]connectv1.ConnectV1ConnectorExpansionStatusTasks, len(s.Tasks))
+	for i, task := range s.Tasks {
+		tasks[i] = connectv1.ConnectV1ConnectorExpansionStatusTasks{Id: task.Id, State: task.State, WorkerId: task.WorkerId}
+		if task.Trace != "" {
+			tasks[i].Msg = connectv1.PtrString(task.Trace)
+		}
+	}
+
+	return &connectv1.ConnectV1ConnectorExpansionStatus{
+		Name:      s.Name,
+		Type:      s.Type,
+		Connector: s.Connector,
+		Tasks:     &tasks,
+	}
+}
+
+type onPremErrorResponse struct {
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+func (c *clusterCommand) getOnPremClient(cmd *cobra.Command) (*onPremClient, error) {
+	connectUrl, err := cmd.Flags().GetString("connect-url")
+	if err != nil {
+		return nil, err
+	}
+
+	if connectUrl == "" {
+		clusterName, err := cmd.Flags().GetString("cluster-name")
+		if err != nil {
+			return nil, err
+		}
+
+		ctx := context.WithValue(context.Background(), mds.ContextAccessToken, c.Context.GetAuthToken())
+		opts := &mds.ClusterRegistryListOpts{ClusterType: optional.NewString(clusterType)}
+
+		clusterInfos, response, err := c.MDSClient.ClusterRegistryApi.ClusterRegistryList(ctx, opts)
+		if err != nil {
+			return nil, cluster.HandleClusterError(err, response)
+		}
+
+		connectUrl, err = getRegisteredConnectUrl(clusterInfos, clusterName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	caCertPath, err := cmd.Flags().GetString("ca-cert-path")
+	if err != nil {
+		return nil, err
+	}
+	if caCertPath == "" {
+		caCertPath = pauth.GetEnvWithFallback(pauth.ConfluentPlatformCACertPath, pauth.DeprecatedConfluentPlatformCACertPath)
+	}
+
+	httpClient := utils.DefaultClient()
+	if caCertPath != "" {
+		httpClient, err = utils.GetCAClient(caCertPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &onPremClient{
+		url:        strings.TrimSuffix(connectUrl, "/"),
+		authToken:  c.Context.GetAuthToken(),
+		userAgent:  c.Version.UserAgent,
+		httpClient: httpClient,
+	}, nil
+}
+
+// getRegisteredConnectUrl returns the URL of the Connect cluster with the given name in the MDS cluster registry.
+// If no name is given, exactly one Connect cluster must be registered.
+func getRegisteredConnectUrl(clusterInfos []mds.ClusterInfo, clusterName string) (string, error) {
+	var clusterInfo *mds.ClusterInfo
+	if clusterName != "" {
+		for i := range clusterInfos {
+			if clusterInfos[i].ClusterName == clusterName {
+				clusterInfo = &clusterInfos[i]
+				break
+			}
+		}
+		if clusterInfo == nil {
+			return "", errors.Errorf(errors.UnknownClusterErrorMsg, clusterName)
+		}
+	} else {
+		switch len(clusterInfos) {
+		case 0:
+			return "", errors.NewErrorWithSuggestions(errors.NoConnectClusterErrorMsg, errors.NoConnectClusterSuggestions)
+		case 1:
+			clusterInfo = &clusterInfos[0]
+		default:
+			return "", errors.NewErrorWithSuggestions(errors.MultipleConnectClustersErrorMsg, errors.MultipleConnectClustersSuggestions)
+		}
+	}
+
+	if len(clusterInfo.Hosts) == 0 {
+		return "", errors.NewErrorWithSuggestions(errors.NoConnectClusterErrorMsg, errors.NoConnectClusterSuggestions)
+	}
+
+	var scheme string
+	switch clusterInfo.Protocol {
+	case "HTTP":
+		scheme = "http"
+	case "HTTPS":
+		scheme = "https"
+	default:
+		return "", errors.Errorf(errors.ProtocolNotSupportedErrorMsg, clusterInfo.Protocol)
+	}
+
+	host := clusterInfo.Hosts[0]
+	return fmt.Sprintf("%s://%s:%d", scheme, host.Host, host.Port), nil
+}
+
+// getConnector returns a connector in the same shape as the Confluent Cloud API, with its name as its ID.
+func (c *onPremClient) getConnector(name string) (*connectv1.ConnectV1ConnectorExpansion, error) {
+	info := new(connectv1.ConnectV1ConnectorExpansionInfo)
+	if err := c.do(http.MethodGet, "/connectors/"+url.PathEscape(name), nil, info); err != nil {
+		return nil, err
+	}
+
+	status := new(onPremConnectorStatus)
+	if err := c.do(http.MethodGet, fmt.Sprintf("/connectors/%s/status", url.PathEscape(name)), nil, status); err != nil {
+		return nil, err
+	}
+
+	return &connectv1.ConnectV1ConnectorExpansion{
+		Id:     &connectv1.ConnectV1ConnectorExpansionId{Id: connectv1.PtrString(name)},
+		Info:   info,
+		Status: status.toExpansionStatus(),
+	}, nil
+}
+
+func (c *onPremClient) createConnector(configs map[string]string) error {
+	return c.do(http.MethodPost, "/connectors", &onPremConnectorRequest{Name: configs[name], Config: configs}, nil)
+}
+
+func (c *onPremClient) updateConnectorConfig(name string, configs map[string]string) error {
+	return c.do(http.MethodPut, fmt.Sprintf("/connectors/%s/config", url.PathEscape(name)), configs, nil)
+}
+
+func (c *onPremClient) pauseConnector(name string) error {
+	return c.do(http.MethodPut, fmt.Sprintf("/connectors/%s/pause", url.PathEscape(name)), nil, nil)
+}
+
+func (c *onPremClient) resumeConnector(name string) error {
+	return c.do(http.MethodPut, fmt.Sprintf("/connectors/%s/resume", url.PathEscape(name)), nil, nil)
+}
+
+func (c *onPremClient) deleteConnector(name string) error {
+	return c.do(http.MethodDelete, "/connectors/"+url.PathEscape(name), nil, nil)
+}
+
+func (c *onPremClient) restartConnector(name string, includeTasks, onlyFailed bool) error {
+	query := url.Values{}
+	query.Set("includeTasks", strconv.FormatBool(includeTasks))
+	query.Set("onlyFailed", strconv.FormatBool(onlyFailed))
+
+	return c.do(http.MethodPost, fmt.Sprintf("/connectors/%s/restart?%s", url.PathEscape(name), query.Encode()), nil, nil)
+}
+
+func (c *onPremClient) restartConnectorTask(name string, taskId int32) error {
+	return c.do(http.MethodPost, fmt.Sprintf("/connectors/%s/tasks/%d/restart", url.PathEscape(name), taskId), nil, nil)
+}
+
+func (c *onPremClient) do(method, path string, body, v interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, c.url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.authToken)
+	req.Header.Set("User-Agent", c.userAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusMultipleChoices {
+		errorResponse := new(onPremErrorResponse)
+		if err := json.NewDecoder(res.Body).Decode(errorResponse); err != nil || errorResponse.Message == "" {
+			return errors.New(res.Status)
+		}
+		return errors.Errorf("%s: %s", res.Status, errorResponse.Message)
+	}
+
+	if v == nil {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
@@ -0,0 +1,72 @@
+package connect
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+	mds "github.com/confluentinc/mds-sdk-go-public/mdsv1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRegisteredConnectUrl(t *testing.T) {
+	req := require.New(t)
+
+	primary := mds.ClusterInfo{ClusterName: "primary", Hosts: []mds.HostInfo{{Host: "connect-0", Port: 8083}, {Host: "connect-1", Port: 8083}}, Protocol: "HTTPS"}
+	secondary := mds.ClusterInfo{ClusterName: "secondary", Hosts: []mds.HostInfo{{Host: "10.5.5.5", Port: 9005}}, Protocol: "HTTP"}
+
+	connectUrl, err := getRegisteredConnectUrl([]mds.ClusterInfo{primary}, "")
+	req.NoError(err)
+	req.Equal("https://connect-0:8083", connectUrl)
+
+	connectUrl, err = getRegisteredConnectUrl([]mds.ClusterInfo{primary, secondary}, "secondary")
+	req.NoError(err)
+	req.Equal("http://10.5.5.5:9005", connectUrl)
+
+	_, err = getRegisteredConnectUrl([]mds.ClusterInfo{primary, secondary}, "")
+	req.EqualError(err, "more than one Connect cluster is registered with the MDS cluster registry")
+
+	_, err = getRegisteredConnectUrl(nil, "")
+	req.EqualError(err, "no Connect cluster is registered with the MDS cluster registry")
+
+	_, err = getRegisteredConnectUrl([]mds.ClusterInfo{primary}, "tertiary")
+	req.EqualError(err, `unknown cluster "tertiary"`)
+
+	_, err = getRegisteredConnectUrl([]mds.ClusterInfo{{ClusterName: "sasl", Hosts: []mds.HostInfo{{Host: "broker", Port: 9092}}, Protocol: "SASL_SSL"}}, "")
+	req.EqualError(err, "protocol SASL_SSL is currently not supported")
+}
+
+func TestOnPremClient_GetConnector(t *testing.T) {
+	req := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/connectors/my-connector":
+			_, _ = fmt.Fprint(w, `{"name": "my-connector", "config": {"connector.class": "FileStreamSink"}, "tasks": [], "type": "sink"}`)
+		case "/connectors/my-connector/status":
+			_, _ = fmt.Fprint(w, `{"name": "my-connector", "connector": {"state": "RUNNING", "worker_id": "connect-0:8083"}, "tasks": [`+
+				`{"id": 0, "state": "RUNNING", "worker_id": "connect-0:8083"}, `+
+				`{"id": 1, "state": "FAILED", "worker_id": "connect-1:8083", "trace": "org.apache.kafka.connect.errors.ConnectException: disk full"}], "type": "sink"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &onPremClient{url: server.URL, httpClient: server.Client()}
+	connector, err := client.getConnector("my-connector")
+	req.NoError(err)
+	req.Equal("my-connector", connector.Id.GetId())
+	req.Equal("FileStreamSink", connector.Info.GetConfig()["connector.class"])
+	req.Equal(&connectv1.ConnectV1ConnectorExpansionStatus{
+		Name:      "my-connector",
+		Type:      "sink",
+		Connector: connectv1.ConnectV1ConnectorExpansionStatusConnector{State: "RUNNING", WorkerId: "connect-0:8083"},
+		Tasks: &[]connectv1.ConnectV1ConnectorExpansionStatusTasks{
+			{Id: 0, State: "RUNNING", WorkerId: "connect-0:8083"},
+			{Id: 1, State: "FAILED", WorkerId: "connect-1:8083", Msg: connectv1.PtrString("org.apache.kafka.connect.errors.ConnectException: disk full")},
+		},
+	}, connector.Status)
+}
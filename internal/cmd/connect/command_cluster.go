@@ -27,16 +27,26 @@ func newClusterCommand(cfg *v1.Config, prerunner pcmd.PreRunner) *cobra.Command
 
 	if cfg.IsCloudLogin() {
 		c.AuthenticatedStateFlagCommand = pcmd.NewAuthenticatedStateFlagCommand(cmd, prerunner)
+		c.AddCommand(c.newApplyCommand())
 		c.AddCommand(c.newCreateCommand())
 		c.AddCommand(c.newDeleteCommand())
 		c.AddCommand(c.newDescribeCommand())
 		c.AddCommand(c.newListCommand())
 		c.AddCommand(c.newPauseCommand())
+		c.AddCommand(c.newRestartCommand())
 		c.AddCommand(c.newResumeCommand())
 		c.AddCommand(c.newUpdateCommand())
+		c.AddCommand(c.newValidateCommand())
 	} else {
 		c.AuthenticatedStateFlagCommand = pcmd.NewAuthenticatedWithMDSStateFlagCommand(cmd, prerunner)
+		c.AddCommand(c.newCreateCommandOnPrem())
+		c.AddCommand(c.newDeleteCommandOnPrem())
+		c.AddCommand(c.newDescribeCommandOnPrem())
 		c.AddCommand(c.newListCommandOnPrem())
+		c.AddCommand(c.newPauseCommandOnPrem())
+		c.AddCommand(c.newRestartCommandOnPrem())
+		c.AddCommand(c.newResumeCommandOnPrem())
+		c.AddCommand(c.newUpdateCommandOnPrem())
 	}
 
 	return c.Command
@@ -0,0 +1,146 @@
+package connect
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/form"
+	"github.com/confluentinc/cli/internal/pkg/output"
+	"github.com/confluentinc/cli/internal/pkg/resource"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+)
+
+func (c *clusterCommand) newApplyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "apply",
+		Short:       "Create and update connectors from a directory of configs.",
+		Long:        clusterApplyLongDescription,
+		Args:        cobra.NoArgs,
+		RunE:        c.apply,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Print the changes needed for the connectors of the current Kafka cluster to match directory "connectors".`,
+				Code: "confluent connect cluster apply --dir connectors --dry-run",
+			},
+			examples.Example{
+				Text: `Create and update connectors to match directory "connectors", and delete the connectors which are not in it.`,
+				Code: "confluent connect cluster apply --dir connectors --prune",
+			},
+		),
+	}
+
+	cmd.Flags().String("dir", "", "The path to a directory of JSON or YAML connector config files.")
+	cmd.Flags().Bool("prune", false, "Delete connectors which are not in the directory.")
+	cmd.Flags().Bool("dry-run", false, "Print the changes without applying them.")
+	pcmd.AddForceFlag(cmd)
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	_ = cmd.MarkFlagRequired("dir")
+
+	return cmd
+}
+
+func (c *clusterCommand) apply(cmd *cobra.Command, _ []string) error {
+	kafkaCluster, err := c.Context.GetKafkaClusterForCommand()
+	if err != nil {
+		return err
+	}
+
+	dir, err := cmd.Flags().GetString("dir")
+	if err != nil {
+		return err
+	}
+
+	prune, err := cmd.Flags().GetBool("prune")
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	specs, err := readConnectorDir(dir, newTemplateContext(c.EnvironmentId(), kafkaCluster))
+	if err != nil {
+		return err
+	}
+
+	connectors, err := c.V2Client.ListConnectorsWithExpansions(c.EnvironmentId(), kafkaCluster.ID, "info")
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]map[string]string, len(connectors))
+	for connectorName, connector := range connectors {
+		current[connectorName] = connector.Info.GetConfig()
+	}
+
+	changes := planConnectorChanges(specs, current, prune)
+	if len(changes) == 0 {
+		utils.ErrPrint(cmd, errors.ConnectorsUpToDateMsg)
+		return nil
+	}
+
+	list := output.NewList(cmd)
+	for _, change := range changes {
+		for _, row := range change.rows() {
+			list.Add(row)
+		}
+	}
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	deletions := 0
+	for _, change := range changes {
+		if change.action == deleteConnectorAction {
+			deletions++
+		}
+	}
+	if deletions > 0 {
+		if ok, err := form.ConfirmDeletion(cmd, fmt.Sprintf(errors.PruneConnectorsConfirmMsg, deletions), ""); err != nil || !ok {
+			return err
+		}
+	}
+
+	for _, change := range changes {
+		switch change.action {
+		case createConnectorAction:
+			connectConfig := connectv1.InlineObject{
+				Name:   connectv1.PtrString(change.connector),
+				Config: &change.spec.configs,
+			}
+			if _, err := c.V2Client.CreateConnector(c.EnvironmentId(), kafkaCluster.ID, connectConfig); err != nil {
+				return err
+			}
+			utils.ErrPrintf(cmd, errors.CreatedResourceMsg, resource.Connector, change.connector)
+		case updateConnectorAction:
+			if _, err := c.V2Client.CreateOrUpdateConnectorConfig(change.connector, c.EnvironmentId(), kafkaCluster.ID, change.spec.configs); err != nil {
+				return err
+			}
+			utils.ErrPrintf(cmd, errors.UpdatedResourceMsg, resource.Connector, change.connector)
+		case deleteConnectorAction:
+			if _, err := c.V2Client.DeleteConnector(change.connector, c.EnvironmentId(), kafkaCluster.ID); err != nil {
+				return err
+			}
+			utils.ErrPrintf(cmd, errors.DeletedResourceMsg, resource.Connector, change.connector)
+		}
+	}
+
+	return nil
+}
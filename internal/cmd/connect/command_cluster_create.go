@@ -20,6 +20,7 @@ func (c *clusterCommand) newCreateCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:         "create",
 		Short:       "Create a connector.",
+		Long:        "Create a connector from a connector config file.\n\n" + configTemplateDescription,
 		Args:        cobra.NoArgs,
 		RunE:        c.create,
 		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
@@ -35,6 +36,7 @@ func (c *clusterCommand) newCreateCommand() *cobra.Command {
 	}
 
 	cmd.Flags().String("config-file", "", "JSON connector config file.")
+	cmd.Flags().Bool("skip-validation", false, "Skip validating the connector configuration before creating the connector.")
 	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddContextFlag(cmd, c.CLICommand)
 	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
@@ -51,11 +53,15 @@ func (c *clusterCommand) create(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	userConfigs, err := getConfig(cmd)
+	userConfigs, err := getConfig(cmd, newTemplateContext(c.EnvironmentId(), kafkaCluster))
 	if err != nil {
 		return err
 	}
 
+	if err := c.preflight(cmd, kafkaCluster.ID, *userConfigs); err != nil {
+		return err
+	}
+
 	connectConfig := connectv1.InlineObject{
 		Name:   connectv1.PtrString((*userConfigs)["name"]),
 		Config: userConfigs,
@@ -0,0 +1,63 @@
+package connect
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/output"
+)
+
+func (c *clusterCommand) newCreateCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "create",
+		Short:       "Create a connector.",
+		Long:        "Create a connector from a connector config file.\n\n" + onPremConfigTemplateDescription,
+		Args:        cobra.NoArgs,
+		RunE:        c.createOnPrem,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Create a connector in the Connect cluster registered with the MDS cluster registry.",
+				Code: "confluent connect cluster create --config-file config.json",
+			},
+			examples.Example{
+				Text: "Create a connector in the specified Connect cluster.",
+				Code: "confluent connect cluster create --config-file config.json --connect-url http://localhost:8083",
+			},
+		),
+	}
+
+	cmd.Flags().String("config-file", "", "JSON connector config file.")
+	cmd.Flags().Bool("resolve-templates", false, "Resolve the template placeholders of the connector config file before sending it to the Connect cluster.")
+	cmd.Flags().AddFlagSet(pcmd.OnPremConnectSet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	_ = cmd.MarkFlagRequired("config-file")
+
+	return cmd
+}
+
+func (c *clusterCommand) createOnPrem(cmd *cobra.Command, _ []string) error {
+	client, err := c.getOnPremClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	userConfigs, err := getOnPremConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := client.createConnector(*userConfigs); err != nil {
+		return err
+	}
+
+	table := output.NewTable(cmd)
+	table.Add(&connectCreateOut{
+		Id:   (*userConfigs)[name],
+		Name: (*userConfigs)[name],
+	})
+	return table.Print()
+}
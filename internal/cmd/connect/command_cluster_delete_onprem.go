@@ -0,0 +1,58 @@
+package connect
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/form"
+	"github.com/confluentinc/cli/internal/pkg/resource"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+)
+
+func (c *clusterCommand) newDeleteCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "delete <name>",
+		Short:       "Delete a connector.",
+		Args:        cobra.ExactArgs(1),
+		RunE:        c.deleteOnPrem,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Delete connector "my-connector".`,
+				Code: "confluent connect cluster delete my-connector",
+			},
+			examples.Example{
+				Code: "confluent connect cluster delete my-connector --connect-url http://localhost:8083",
+			},
+		),
+	}
+
+	pcmd.AddForceFlag(cmd)
+	cmd.Flags().AddFlagSet(pcmd.OnPremConnectSet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+
+	return cmd
+}
+
+func (c *clusterCommand) deleteOnPrem(cmd *cobra.Command, args []string) error {
+	client, err := c.getOnPremClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	promptMsg := fmt.Sprintf(errors.DeleteResourceConfirmYesNoMsg, resource.Connector, args[0])
+	if ok, err := form.ConfirmDeletion(cmd, promptMsg, ""); err != nil || !ok {
+		return err
+	}
+
+	if err := client.deleteConnector(args[0]); err != nil {
+		return err
+	}
+
+	utils.Printf(cmd, errors.DeletedResourceMsg, resource.Connector, args[0])
+	return nil
+}
@@ -1,11 +1,16 @@
 package connect
 
 import (
+	"fmt"
+	"sort"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
 
 	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
 	"github.com/confluentinc/cli/internal/pkg/examples"
 	"github.com/confluentinc/cli/internal/pkg/output"
 	"github.com/confluentinc/cli/internal/pkg/utils"
@@ -61,9 +66,15 @@ func (c *clusterCommand) newDescribeCommand() *cobra.Command {
 			examples.Example{
 				Code: "confluent connect cluster describe lcc-123456 --cluster lkc-123456",
 			},
+			examples.Example{
+				Text: "Print the state transitions of a connector and its tasks until it fails.",
+				Code: "confluent connect cluster describe lcc-123456 --watch",
+			},
 		),
 	}
 
+	cmd.Flags().Bool("watch", false, "Poll the status of the connector and print its state transitions and traces, exiting with an error if the connector or any of its tasks fails.")
+	cmd.Flags().Duration("interval", 10*time.Second, "The interval between polls of the status when watching.")
 	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddContextFlag(cmd, c.CLICommand)
 	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
@@ -78,6 +89,26 @@ func (c *clusterCommand) describe(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	watch, err := cmd.Flags().GetBool("watch")
+	if err != nil {
+		return err
+	}
+	if watch {
+		if output.GetFormat(cmd) != output.Human {
+			return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, "watch", output.FlagName)
+		}
+
+		interval, err := cmd.Flags().GetDuration("interval")
+		if err != nil {
+			return err
+		}
+		if interval <= 0 {
+			return errors.New(errors.InvalidWatchIntervalErrorMsg)
+		}
+
+		return c.watch(cmd, args[0], kafkaCluster.ID, interval)
+	}
+
 	connector, err := c.V2Client.GetConnectorExpansionById(args[0], c.EnvironmentId(), kafkaCluster.ID)
 	if err != nil {
 		return err
@@ -95,6 +126,28 @@ func (c *clusterCommand) describe(cmd *cobra.Command, args []string) error {
 	return printSerializedDescribe(cmd, connector)
 }
 
+// watch polls the status of a connector until it or any of its tasks fails.
+func (c *clusterCommand) watch(cmd *cobra.Command, id, kafkaClusterId string, interval time.Duration) error {
+	var previous *connectv1.ConnectV1ConnectorExpansionStatus
+	for {
+		connector, err := c.V2Client.GetConnectorExpansionById(id, c.EnvironmentId(), kafkaClusterId)
+		if err != nil {
+			return err
+		}
+
+		for _, line := range connectorStatusChanges(previous, connector.Status) {
+			utils.Println(cmd, line)
+		}
+
+		if isFailed(connector.Status) {
+			return errors.NewErrorWithSuggestions(fmt.Sprintf(errors.ConnectorFailedErrorMsg, id), fmt.Sprintf(errors.ConnectorFailedSuggestions, id))
+		}
+
+		previous = connector.Status
+		time.Sleep(interval)
+	}
+}
+
 func printHumanDescribe(cmd *cobra.Command, connector *connectv1.ConnectV1ConnectorExpansion) error {
 	utils.Println(cmd, "Connector Details")
 	table := output.NewTable(cmd)
@@ -142,6 +195,7 @@ func printSerializedDescribe(cmd *cobra.Command, connector *connectv1.ConnectV1C
 	for name, value := range connector.Info.GetConfig() {
 		configs = append(configs, serializedConfigsOut{Config: name, Value: value})
 	}
+	sort.Slice(configs, func(i, j int) bool { return configs[i].Config < configs[j].Config })
 
 	out := &serializedDescribeOut{
 		Connector: &serializedConnectorOut{
@@ -0,0 +1,52 @@
+package connect
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/output"
+)
+
+func (c *clusterCommand) newDescribeCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "describe <name>",
+		Short:       "Describe a connector.",
+		Args:        cobra.ExactArgs(1),
+		RunE:        c.describeOnPrem,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Describe connector and task level details of connector "my-connector".`,
+				Code: "confluent connect cluster describe my-connector",
+			},
+			examples.Example{
+				Code: "confluent connect cluster describe my-connector --connect-url http://localhost:8083",
+			},
+		),
+	}
+
+	cmd.Flags().AddFlagSet(pcmd.OnPremConnectSet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *clusterCommand) describeOnPrem(cmd *cobra.Command, args []string) error {
+	client, err := c.getOnPremClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	connector, err := client.getConnector(args[0])
+	if err != nil {
+		return err
+	}
+
+	if output.GetFormat(cmd) == output.Human {
+		return printHumanDescribe(cmd, connector)
+	}
+
+	return printSerializedDescribe(cmd, connector)
+}
@@ -0,0 +1,48 @@
+package connect
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+)
+
+func (c *clusterCommand) newPauseCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "pause <name-1> [name-2] ... [name-N]",
+		Short:       "Pause connectors.",
+		Args:        cobra.MinimumNArgs(1),
+		RunE:        c.pauseOnPrem,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Pause connectors "my-connector-1" and "my-connector-2":`,
+				Code: "confluent connect cluster pause my-connector-1 my-connector-2",
+			},
+		),
+	}
+
+	cmd.Flags().AddFlagSet(pcmd.OnPremConnectSet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+
+	return cmd
+}
+
+func (c *clusterCommand) pauseOnPrem(cmd *cobra.Command, args []string) error {
+	client, err := c.getOnPremClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range args {
+		if err := client.pauseConnector(name); err != nil {
+			return err
+		}
+
+		utils.Printf(cmd, errors.PausedConnectorMsg, name)
+	}
+
+	return nil
+}
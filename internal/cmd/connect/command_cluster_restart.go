@@ -0,0 +1,89 @@
+package connect
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+)
+
+func (c *clusterCommand) newRestartCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "restart <id>",
+		Short:             "Restart a connector and its tasks.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
+		RunE:              c.restart,
+		Annotations:       map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Restart connector "lcc-123456" and its tasks.`,
+				Code: "confluent connect cluster restart lcc-123456",
+			},
+			examples.Example{
+				Text: `Restart the failed tasks of connector "lcc-123456", and the connector if it has failed.`,
+				Code: "confluent connect cluster restart lcc-123456 --failed-only",
+			},
+			examples.Example{
+				Text: `Restart task 0 of connector "lcc-123456".`,
+				Code: "confluent connect cluster restart lcc-123456 --task 0",
+			},
+		),
+	}
+
+	cmd.Flags().Bool("failed-only", false, "Only restart the connector and tasks which have failed.")
+	cmd.Flags().Int32("task", 0, "The ID of a single task to restart.")
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+
+	return cmd
+}
+
+func (c *clusterCommand) restart(cmd *cobra.Command, args []string) error {
+	kafkaCluster, err := c.Context.GetKafkaClusterForCommand()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("failed-only") && cmd.Flags().Changed("task") {
+		return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, "failed-only", "task")
+	}
+
+	failedOnly, err := cmd.Flags().GetBool("failed-only")
+	if err != nil {
+		return err
+	}
+
+	task, err := cmd.Flags().GetInt32("task")
+	if err != nil {
+		return err
+	}
+
+	connector, err := c.V2Client.GetConnectorExpansionById(args[0], c.EnvironmentId(), kafkaCluster.ID)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("task") {
+		if !hasTask(connector.Status.GetTasks(), task) {
+			return errors.Errorf(errors.UnknownConnectorTaskErrorMsg, task, args[0])
+		}
+
+		if err := c.V2Client.RestartConnectorTask(connector.Info.GetName(), c.EnvironmentId(), kafkaCluster.ID, task); err != nil {
+			return err
+		}
+
+		utils.Printf(cmd, errors.RestartedConnectorTaskMsg, task, args[0])
+		return nil
+	}
+
+	if err := c.V2Client.RestartConnector(connector.Info.GetName(), c.EnvironmentId(), kafkaCluster.ID, true, failedOnly); err != nil {
+		return err
+	}
+
+	utils.Printf(cmd, errors.RestartedConnectorMsg, args[0])
+	return nil
+}
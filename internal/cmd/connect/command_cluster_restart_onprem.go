@@ -0,0 +1,78 @@
+package connect
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+)
+
+func (c *clusterCommand) newRestartCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "restart <name>",
+		Short:       "Restart a connector and its tasks.",
+		Args:        cobra.ExactArgs(1),
+		RunE:        c.restartOnPrem,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Restart connector "my-connector" and its tasks.`,
+				Code: "confluent connect cluster restart my-connector",
+			},
+			examples.Example{
+				Text: `Restart the failed tasks of connector "my-connector", and the connector if it has failed.`,
+				Code: "confluent connect cluster restart my-connector --failed-only",
+			},
+			examples.Example{
+				Text: `Restart task 0 of connector "my-connector".`,
+				Code: "confluent connect cluster restart my-connector --task 0",
+			},
+		),
+	}
+
+	cmd.Flags().Bool("failed-only", false, "Only restart the connector and tasks which have failed.")
+	cmd.Flags().Int32("task", 0, "The ID of a single task to restart.")
+	cmd.Flags().AddFlagSet(pcmd.OnPremConnectSet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+
+	return cmd
+}
+
+func (c *clusterCommand) restartOnPrem(cmd *cobra.Command, args []string) error {
+	if cmd.Flags().Changed("failed-only") && cmd.Flags().Changed("task") {
+		return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, "failed-only", "task")
+	}
+
+	failedOnly, err := cmd.Flags().GetBool("failed-only")
+	if err != nil {
+		return err
+	}
+
+	task, err := cmd.Flags().GetInt32("task")
+	if err != nil {
+		return err
+	}
+
+	client, err := c.getOnPremClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("task") {
+		if err := client.restartConnectorTask(args[0], task); err != nil {
+			return err
+		}
+
+		utils.Printf(cmd, errors.RestartedConnectorTaskMsg, task, args[0])
+		return nil
+	}
+
+	if err := client.restartConnector(args[0], true, failedOnly); err != nil {
+		return err
+	}
+
+	utils.Printf(cmd, errors.RestartedConnectorMsg, args[0])
+	return nil
+}
@@ -14,6 +14,7 @@ func (c *clusterCommand) newUpdateCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:         "update <id>",
 		Short:       "Update a connector configuration.",
+		Long:        "Update a connector configuration, either from a connector config file or by overriding configs with `--config`.\n\n" + configTemplateDescription,
 		Args:        cobra.ExactArgs(1),
 		RunE:        c.update,
 		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
@@ -21,6 +22,7 @@ func (c *clusterCommand) newUpdateCommand() *cobra.Command {
 
 	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the connector being updated.`)
 	cmd.Flags().String("config-file", "", "JSON connector config file.")
+	cmd.Flags().Bool("skip-validation", false, "Skip validating the connector configuration before updating the connector.")
 	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddContextFlag(cmd, c.CLICommand)
 	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
@@ -56,7 +58,7 @@ func (c *clusterCommand) update(cmd *cobra.Command, args []string) error {
 		}
 		userConfigs = &currentConfigs
 	} else if cmd.Flags().Changed("config-file") {
-		userConfigs, err = getConfig(cmd)
+		userConfigs, err = getConfig(cmd, newTemplateContext(c.EnvironmentId(), kafkaCluster))
 		if err != nil {
 			return err
 		}
@@ -64,6 +66,10 @@ func (c *clusterCommand) update(cmd *cobra.Command, args []string) error {
 		return errors.New("one of `--config` or `--config-file` must be specified")
 	}
 
+	if err := c.preflight(cmd, kafkaCluster.ID, *userConfigs); err != nil {
+		return err
+	}
+
 	connector, err := c.V2Client.GetConnectorExpansionById(args[0], c.EnvironmentId(), kafkaCluster.ID)
 	if err != nil {
 		return err
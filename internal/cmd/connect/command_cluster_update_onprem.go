@@ -0,0 +1,74 @@
+package connect
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/properties"
+	"github.com/confluentinc/cli/internal/pkg/resource"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+)
+
+func (c *clusterCommand) newUpdateCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "update <name>",
+		Short:       "Update a connector configuration.",
+		Long:        "Update a connector configuration, either from a connector config file or by overriding configs with `--config`.\n\n" + onPremConfigTemplateDescription,
+		Args:        cobra.ExactArgs(1),
+		RunE:        c.updateOnPrem,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+	}
+
+	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the connector being updated.`)
+	cmd.Flags().String("config-file", "", "JSON connector config file.")
+	cmd.Flags().Bool("resolve-templates", false, "Resolve the template placeholders of the connector config file before sending it to the Connect cluster.")
+	cmd.Flags().AddFlagSet(pcmd.OnPremConnectSet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+
+	return cmd
+}
+
+func (c *clusterCommand) updateOnPrem(cmd *cobra.Command, args []string) error {
+	client, err := c.getOnPremClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	var userConfigs *map[string]string
+	if cmd.Flags().Changed("config") {
+		configs, err := cmd.Flags().GetStringSlice("config")
+		if err != nil {
+			return err
+		}
+		configMap, err := properties.ConfigFlagToMap(configs)
+		if err != nil {
+			return err
+		}
+
+		connector, err := client.getConnector(args[0])
+		if err != nil {
+			return err
+		}
+		currentConfigs := connector.Info.GetConfig()
+
+		for name, value := range configMap {
+			currentConfigs[name] = value
+		}
+		userConfigs = &currentConfigs
+	} else if cmd.Flags().Changed("config-file") {
+		userConfigs, err = getOnPremConfig(cmd)
+		if err != nil {
+			return err
+		}
+	} else {
+		return errors.New("one of `--config` or `--config-file` must be specified")
+	}
+
+	if err := client.updateConnectorConfig(args[0], *userConfigs); err != nil {
+		return err
+	}
+
+	utils.Printf(cmd, errors.UpdatedResourceMsg, resource.Connector, args[0])
+	return nil
+}
@@ -0,0 +1,132 @@
+package connect
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/output"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+)
+
+type connectValidateOut struct {
+	Config            string   `human:"Config" serialized:"config"`
+	Errors            []string `human:"Errors" serialized:"errors"`
+	RecommendedValues []string `human:"Recommended Values" serialized:"recommended_values"`
+}
+
+func (c *clusterCommand) newValidateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "validate",
+		Short:       "Validate a connector configuration.",
+		Long:        "Validate a connector configuration against its plugin, and list every config with an error.\n\n" + configTemplateDescription,
+		Args:        cobra.NoArgs,
+		RunE:        c.validate,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Validate a connector configuration in the current or specified Kafka cluster context.",
+				Code: "confluent connect cluster validate --config-file config.json",
+			},
+			examples.Example{
+				Code: "confluent connect cluster validate --config-file config.json --cluster lkc-123456",
+			},
+		),
+	}
+
+	cmd.Flags().String("config-file", "", "JSON connector config file.")
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	_ = cmd.MarkFlagRequired("config-file")
+
+	return cmd
+}
+
+func (c *clusterCommand) validate(cmd *cobra.Command, _ []string) error {
+	kafkaCluster, err := c.Context.GetKafkaClusterForCommand()
+	if err != nil {
+		return err
+	}
+
+	userConfigs, err := getConfig(cmd, newTemplateContext(c.EnvironmentId(), kafkaCluster))
+	if err != nil {
+		return err
+	}
+
+	configErrors, err := c.getConfigErrors(kafkaCluster.ID, *userConfigs)
+	if err != nil {
+		return err
+	}
+
+	if len(configErrors) == 0 && output.GetFormat(cmd) == output.Human {
+		utils.Print(cmd, errors.ValidConnectorConfigMsg)
+		return nil
+	}
+
+	if err := printConfigErrors(cmd, configErrors); err != nil {
+		return err
+	}
+
+	if len(configErrors) > 0 {
+		return errors.Errorf(errors.InvalidConnectorConfigErrorMsg, len(configErrors))
+	}
+	return nil
+}
+
+// preflight validates a connector configuration before it is submitted, unless `--skip-validation` is set.
+func (c *clusterCommand) preflight(cmd *cobra.Command, kafkaClusterId string, configs map[string]string) error {
+	skipValidation, err := cmd.Flags().GetBool("skip-validation")
+	if err != nil {
+		return err
+	}
+	if skipValidation {
+		return nil
+	}
+
+	configErrors, err := c.getConfigErrors(kafkaClusterId, configs)
+	if err != nil {
+		return err
+	}
+	if len(configErrors) == 0 {
+		return nil
+	}
+
+	if err := printConfigErrors(cmd, configErrors); err != nil {
+		return err
+	}
+	return errors.NewErrorWithSuggestions(fmt.Sprintf(errors.InvalidConnectorConfigErrorMsg, len(configErrors)), errors.InvalidConnectorConfigSuggestions)
+}
+
+func (c *clusterCommand) getConfigErrors(kafkaClusterId string, configs map[string]string) ([]*connectValidateOut, error) {
+	reply, err := c.V2Client.ValidateConnectorPlugin(configs[connectorClass], c.EnvironmentId(), kafkaClusterId, configs)
+	if err != nil {
+		return nil, errors.NewWrapErrorWithSuggestions(err, errors.InvalidCloudErrorMsg, errors.InvalidCloudSuggestions)
+	}
+
+	var configErrors []*connectValidateOut
+	for _, config := range reply.GetConfigs() {
+		if len(config.Value.GetErrors()) == 0 {
+			continue
+		}
+		configErrors = append(configErrors, &connectValidateOut{
+			Config:            config.Value.GetName(),
+			Errors:            config.Value.GetErrors(),
+			RecommendedValues: config.Value.GetRecommendedValues(),
+		})
+	}
+	return configErrors, nil
+}
+
+func printConfigErrors(cmd *cobra.Command, configErrors []*connectValidateOut) error {
+	list := output.NewList(cmd)
+	for _, configError := range configErrors {
+		list.Add(configError)
+	}
+	return list.Print()
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
@@ -12,6 +13,7 @@ import (
 	"github.com/confluentinc/cli/internal/pkg/ccloudv2"
 	"github.com/confluentinc/cli/internal/pkg/ccstructs"
 	v1 "github.com/confluentinc/cli/internal/pkg/config/v1"
+	"github.com/confluentinc/cli/internal/pkg/errors"
 	climock "github.com/confluentinc/cli/mock"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
@@ -32,13 +34,19 @@ var (
 
 	pluginDescribe = connectv1.InlineResponse2003Configs{
 		Value: &connectv1.InlineResponse2003Value{
-			Name:   connectv1.PtrString("name"),
-			Errors: &[]string{`"name" is required`}},
+			Name: connectv1.PtrString("name")},
 		Definition: &connectv1.InlineResponse2003Definition{
 			Documentation: connectv1.PtrString("Connector Name"),
 			Required:      connectv1.PtrBool(true)},
 	}
 
+	pluginInvalid = connectv1.InlineResponse2003Configs{
+		Value: &connectv1.InlineResponse2003Value{
+			Name:              connectv1.PtrString("data.format"),
+			Errors:            &[]string{`Value "XML" doesn't belong to the property's "data.format" enum`},
+			RecommendedValues: &[]string{"AVRO", "JSON"}},
+	}
+
 	connector = connectv1.ConnectV1Connector{
 		Name:   connectorName,
 		Config: map[string]string{},
@@ -179,6 +187,26 @@ func (suite *ConnectTestSuite) TestDeleteConnector() {
 	req.True(suite.connectorsMock.DeleteConnectv1ConnectorExecuteCalled())
 }
 
+func (suite *ConnectTestSuite) TestDescribeConnectorWatch() {
+	suite.connectorsMock.ListConnectv1ConnectorsWithExpansionsExecuteFunc = func(_ connectv1.ApiListConnectv1ConnectorsWithExpansionsRequest) (map[string]connectv1.ConnectV1ConnectorExpansion, *http.Response, error) {
+		failedExpansion := connectorExpansion
+		failedExpansion.Status = &connectv1.ConnectV1ConnectorExpansionStatus{
+			Name:      connectorName,
+			Connector: connectv1.ConnectV1ConnectorExpansionStatusConnector{State: "FAILED", Trace: connectv1.PtrString("invalid credentials")},
+		}
+		return map[string]connectv1.ConnectV1ConnectorExpansion{connectorName: failedExpansion}, nil, nil
+	}
+
+	cmd := suite.newCmd()
+	cmd.SetArgs([]string{"cluster", "describe", connectorID, "--watch"})
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	err := cmd.Execute()
+	req := require.New(suite.T())
+	req.EqualError(err, fmt.Sprintf(`connector "%s" has failed`, connectorID))
+	req.True(strings.HasPrefix(buf.String(), fmt.Sprintf("Connector \"%s\" is FAILED.\nConnector \"%s\" trace: invalid credentials\n", connectorName, connectorName)))
+}
+
 func (suite *ConnectTestSuite) TestListConnectors() {
 	cmd := suite.newCmd()
 	cmd.SetArgs([]string{"cluster", "list"})
@@ -246,6 +274,41 @@ func (suite *ConnectTestSuite) TestCreateConnectorMalformedOldFormat() {
 	req.Contains(err.Error(), "unable to parse config")
 }
 
+func (suite *ConnectTestSuite) TestCreateConnectorInvalidConfig() {
+	suite.pluginMock.ValidateConnectv1ConnectorPluginExecuteFunc = func(_ connectv1.ApiValidateConnectv1ConnectorPluginRequest) (connectv1.InlineResponse2003, *http.Response, error) {
+		return connectv1.InlineResponse2003{Configs: &[]connectv1.InlineResponse2003Configs{pluginDescribe, pluginInvalid}}, nil, nil
+	}
+
+	cmd := suite.newCmd()
+	cmd.SetArgs([]string{"cluster", "create", "--config-file", "../../../test/fixtures/input/connect/config.yaml"})
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	err := cmd.Execute()
+	req := require.New(suite.T())
+	req.EqualError(err, "connector configuration has 1 invalid config(s)")
+	req.Contains(buf.String(), "data.format")
+	req.False(suite.connectorsMock.CreateConnectv1ConnectorCalled())
+
+	cmd = suite.newCmd()
+	cmd.SetArgs([]string{"cluster", "create", "--config-file", "../../../test/fixtures/input/connect/config.yaml", "--skip-validation"})
+	cmd.SetOut(new(bytes.Buffer))
+	err = cmd.Execute()
+	req.NoError(err)
+	req.True(suite.connectorsMock.CreateConnectv1ConnectorCalled())
+}
+
+func (suite *ConnectTestSuite) TestValidateConnector() {
+	cmd := suite.newCmd()
+	cmd.SetArgs([]string{"cluster", "validate", "--config-file", "../../../test/fixtures/input/connect/config.yaml"})
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	err := cmd.Execute()
+	req := require.New(suite.T())
+	req.NoError(err)
+	req.True(suite.pluginMock.ValidateConnectv1ConnectorPluginExecuteCalled())
+	req.Equal(errors.ValidConnectorConfigMsg, buf.String())
+}
+
 func (suite *ConnectTestSuite) TestUpdateConnector() {
 	cmd := suite.newCmd()
 	cmd.SetArgs([]string{"cluster", "update", connectorID, "--config-file", "../../../test/fixtures/input/connect/config.yaml"})
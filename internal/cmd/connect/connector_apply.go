@@ -0,0 +1,234 @@
+package connect
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-yaml/yaml"
+
+	"github.com/confluentinc/cli/internal/pkg/errors"
+)
+
+const (
+	createConnectorAction = "create"
+	updateConnectorAction = "update"
+	deleteConnectorAction = "delete"
+)
+
+const clusterApplyLongDescription = "Create and update connectors to match a directory of connector configs. " +
+	"Each JSON or YAML file in the directory has the config of one connector, in the same format as the `--config-file` used to create a connector.\n\n" +
+	configTemplateDescription + "\n\n" +
+	"The changes are printed before they are applied, with placeholders in place of their values. " +
+	"Only the configs in the directory are compared, and configs which Confluent Cloud masks are assumed to be unchanged. " +
+	"If `--prune` is set, connectors which are not in the directory are deleted."
+
+// connectorSpec is the desired config of a connector, read from a file of a connector directory.
+type connectorSpec struct {
+	file       string
+	name       string
+	rawConfigs map[string]string
+	configs    map[string]string
+}
+
+type connectorChange struct {
+	action    string
+	connector string
+	spec      *connectorSpec
+	configs   []string
+	current   map[string]string
+}
+
+type connectorPlanOut struct {
+	Connector string `human:"Connector" serialized:"connector"`
+	Action    string `human:"Action" serialized:"action"`
+	Config    string `human:"Config,omitempty" serialized:"config,omitempty"`
+	Current   string `human:"Current Value,omitempty" serialized:"current,omitempty"`
+	Desired   string `human:"Desired Value,omitempty" serialized:"desired,omitempty"`
+}
+
+// readConnectorDir reads the JSON and YAML files of a directory, one connector per file.
+// Other files and subdirectories are ignored, so that they can hold the files which secrets are read from.
+func readConnectorDir(dir string, templateContext map[string]string) ([]*connectorSpec, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var specs []*connectorSpec
+	files := map[string]string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".json", ".yaml", ".yml":
+		default:
+			continue
+		}
+
+		spec, err := readConnectorFile(dir, entry.Name(), templateContext)
+		if err != nil {
+			return nil, err
+		}
+
+		if file, ok := files[spec.name]; ok {
+			return nil, errors.Errorf(errors.InvalidConnectorDirErrorMsg, dir, fmt.Sprintf(`connector "%s" is defined in both "%s" and "%s"`, spec.name, file, spec.file))
+		}
+		files[spec.name] = spec.file
+
+		specs = append(specs, spec)
+	}
+
+	if len(specs) == 0 {
+		return nil, errors.Errorf(errors.InvalidConnectorDirErrorMsg, dir, "no JSON or YAML files found")
+	}
+
+	return specs, nil
+}
+
+func readConnectorFile(dir, file string, templateContext map[string]string) (*connectorSpec, error) {
+	path := filepath.Join(dir, file)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.Errorf(errors.EmptyConfigFileErrorMsg, path)
+	}
+
+	// JSON is a subset of YAML, so both formats are read the same way.
+	var options map[string]interface{}
+	if err := yaml.Unmarshal(data, &options); err != nil {
+		return nil, errors.Wrapf(err, errors.ParseConfigErrorMsg, path)
+	}
+	if configMap, ok := options[config].(map[interface{}]interface{}); ok {
+		options[config] = toStringScalars(toStringKeys(configMap))
+	}
+	toStringScalars(options)
+
+	rawConfigs, err := toConnectorConfig(options)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse config %s", path)
+	}
+
+	_, nameExists := rawConfigs[name]
+	_, classExists := rawConfigs[connectorClass]
+	if !nameExists || !classExists {
+		return nil, errors.Errorf(errors.MissingRequiredConfigsErrorMsg, path)
+	}
+
+	configs := make(map[string]string, len(rawConfigs))
+	for key, value := range rawConfigs {
+		configs[key], err = resolveTemplate(value, dir, templateContext)
+		if err != nil {
+			return nil, wrapTemplateError(err, key, path)
+		}
+	}
+
+	return &connectorSpec{file: file, name: rawConfigs[name], rawConfigs: rawConfigs, configs: configs}, nil
+}
+
+func toStringKeys(m map[interface{}]interface{}) map[string]interface{} {
+	stringMap := make(map[string]interface{}, len(m))
+	for key, val := range m {
+		stringMap[fmt.Sprint(key)] = val
+	}
+	return stringMap
+}
+
+// toStringScalars converts the values that YAML decodes as numbers or booleans, such as an unquoted `tasks.max: 1`,
+// to the strings that connector configs are made of.
+func toStringScalars(m map[string]interface{}) map[string]interface{} {
+	for key, val := range m {
+		switch val.(type) {
+		case int, int64, uint64, float64, bool:
+			m[key] = fmt.Sprint(val)
+		}
+	}
+	return m
+}
+
+// planConnectorChanges compares the desired configs of connectors to their current configs, keyed by connector name,
+// and returns the changes needed to converge them.
+func planConnectorChanges(specs []*connectorSpec, current map[string]map[string]string, prune bool) []*connectorChange {
+	var changes []*connectorChange
+	for _, spec := range specs {
+		currentConfigs, ok := current[spec.name]
+		if !ok {
+			changes = append(changes, &connectorChange{action: createConnectorAction, connector: spec.name, spec: spec})
+			continue
+		}
+
+		var configs []string
+		for key, value := range spec.configs {
+			currentValue, ok := currentConfigs[key]
+			if ok && isMasked(currentValue) {
+				continue
+			}
+			if !ok || currentValue != value {
+				configs = append(configs, key)
+			}
+		}
+		sort.Strings(configs)
+
+		if len(configs) > 0 {
+			changes = append(changes, &connectorChange{action: updateConnectorAction, connector: spec.name, spec: spec, configs: configs, current: currentConfigs})
+		}
+	}
+
+	if prune {
+		desired := map[string]bool{}
+		for _, spec := range specs {
+			desired[spec.name] = true
+		}
+
+		var deleted []string
+		for connector := range current {
+			if !desired[connector] {
+				deleted = append(deleted, connector)
+			}
+		}
+		sort.Strings(deleted)
+
+		for _, connector := range deleted {
+			changes = append(changes, &connectorChange{action: deleteConnectorAction, connector: connector})
+		}
+	}
+
+	return changes
+}
+
+// isMasked returns true for the values of sensitive configs, which Confluent Cloud replaces with asterisks.
+func isMasked(value string) bool {
+	return value != "" && strings.Trim(value, "*") == ""
+}
+
+// rows returns the plan of a change, with one row for each config it sets.
+func (c *connectorChange) rows() []*connectorPlanOut {
+	switch c.action {
+	case createConnectorAction:
+		keys := make([]string, 0, len(c.spec.rawConfigs))
+		for key := range c.spec.rawConfigs {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		rows := make([]*connectorPlanOut, len(keys))
+		for i, key := range keys {
+			rows[i] = &connectorPlanOut{Connector: c.connector, Action: c.action, Config: key, Desired: c.spec.rawConfigs[key]}
+		}
+		return rows
+	case updateConnectorAction:
+		rows := make([]*connectorPlanOut, len(c.configs))
+		for i, key := range c.configs {
+			rows[i] = &connectorPlanOut{Connector: c.connector, Action: c.action, Config: key, Current: c.current[key], Desired: c.spec.rawConfigs[key]}
+		}
+		return rows
+	default:
+		return []*connectorPlanOut{{Connector: c.connector, Action: c.action}}
+	}
+}
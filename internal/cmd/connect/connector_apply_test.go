@@ -0,0 +1,87 @@
+package connect
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadConnectorDir(t *testing.T) {
+	req := require.New(t)
+
+	t.Setenv("KAFKA_API_SECRET", "secret")
+
+	dir := t.TempDir()
+	req.NoError(os.Mkdir(filepath.Join(dir, "secrets"), 0755))
+	req.NoError(os.WriteFile(filepath.Join(dir, "secrets", "password"), []byte("hunter2\n"), 0600))
+	req.NoError(os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Connectors\n"), 0644))
+	req.NoError(os.WriteFile(filepath.Join(dir, "gcs.json"), []byte(`{"name": "gcs", "config": {"connector.class": "GcsSink", "kafka.api.secret": "${env:KAFKA_API_SECRET}", "tasks.max": 2}}`), 0644))
+	req.NoError(os.WriteFile(filepath.Join(dir, "postgres.yaml"), []byte("name: postgres\nconnector.class: PostgresSource\nconnection.url: jdbc:postgresql://db:5432/orders?password=${file:secrets/password}\ntasks.max: 1\nauto.create: true\n"), 0644))
+
+	specs, err := readConnectorDir(dir, nil)
+	req.NoError(err)
+	req.Equal([]*connectorSpec{
+		{
+			file:       "gcs.json",
+			name:       "gcs",
+			rawConfigs: map[string]string{"name": "gcs", "connector.class": "GcsSink", "kafka.api.secret": "${env:KAFKA_API_SECRET}", "tasks.max": "2"},
+			configs:    map[string]string{"name": "gcs", "connector.class": "GcsSink", "kafka.api.secret": "secret", "tasks.max": "2"},
+		},
+		{
+			file:       "postgres.yaml",
+			name:       "postgres",
+			rawConfigs: map[string]string{"name": "postgres", "connector.class": "PostgresSource", "connection.url": "jdbc:postgresql://db:5432/orders?password=${file:secrets/password}", "tasks.max": "1", "auto.create": "true"},
+			configs:    map[string]string{"name": "postgres", "connector.class": "PostgresSource", "connection.url": "jdbc:postgresql://db:5432/orders?password=hunter2", "tasks.max": "1", "auto.create": "true"},
+		},
+	}, specs)
+
+	req.NoError(os.WriteFile(filepath.Join(dir, "gcs-copy.yml"), []byte("name: gcs\nconnector.class: GcsSink\n"), 0644))
+	_, err = readConnectorDir(dir, nil)
+	req.EqualError(err, `invalid connector directory "`+dir+`": connector "gcs" is defined in both "gcs-copy.yml" and "gcs.json"`)
+	req.NoError(os.Remove(filepath.Join(dir, "gcs-copy.yml")))
+
+	req.NoError(os.WriteFile(filepath.Join(dir, "gcs.json"), []byte(`{"name": "gcs", "connector.class": "GcsSink", "gcs.credentials.config": "${env:GCS_CREDENTIALS_CONFIG}"}`), 0644))
+	_, err = readConnectorDir(dir, nil)
+	req.EqualError(err, `unable to resolve the placeholders of config "gcs.credentials.config" in "`+filepath.Join(dir, "gcs.json")+`": environment variable "GCS_CREDENTIALS_CONFIG" is not set`)
+
+	_, err = readConnectorDir(filepath.Join(dir, "secrets"), nil)
+	req.EqualError(err, `invalid connector directory "`+filepath.Join(dir, "secrets")+`": no JSON or YAML files found`)
+}
+
+func TestPlanConnectorChanges(t *testing.T) {
+	req := require.New(t)
+
+	specs := []*connectorSpec{
+		{
+			name:       "gcs",
+			rawConfigs: map[string]string{"name": "gcs", "kafka.api.secret": "${env:KAFKA_API_SECRET}", "tasks.max": "2"},
+			configs:    map[string]string{"name": "gcs", "kafka.api.secret": "secret", "tasks.max": "2"},
+		},
+		{
+			name:       "postgres",
+			rawConfigs: map[string]string{"name": "postgres", "tasks.max": "1"},
+			configs:    map[string]string{"name": "postgres", "tasks.max": "1"},
+		},
+		{
+			name:       "s3",
+			rawConfigs: map[string]string{"name": "s3"},
+			configs:    map[string]string{"name": "s3"},
+		},
+	}
+	current := map[string]map[string]string{
+		"gcs":      {"name": "gcs", "kafka.api.secret": "****************", "tasks.max": "1", "kafka.endpoint": "SASL_SSL://pkc-12345.us-west-2.aws.confluent.cloud:9092"},
+		"postgres": {"name": "postgres", "tasks.max": "1"},
+		"legacy":   {"name": "legacy"},
+	}
+
+	changes := planConnectorChanges(specs, current, false)
+	req.Len(changes, 2)
+	req.Equal([]*connectorPlanOut{{Connector: "gcs", Action: updateConnectorAction, Config: "tasks.max", Current: "1", Desired: "2"}}, changes[0].rows())
+	req.Equal([]*connectorPlanOut{{Connector: "s3", Action: createConnectorAction, Config: "name", Desired: "s3"}}, changes[1].rows())
+
+	changes = planConnectorChanges(specs, current, true)
+	req.Len(changes, 3)
+	req.Equal([]*connectorPlanOut{{Connector: "legacy", Action: deleteConnectorAction}}, changes[2].rows())
+}
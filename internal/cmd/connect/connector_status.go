@@ -0,0 +1,99 @@
+package connect
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/fatih/color"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+)
+
+const (
+	failedState  = "FAILED"
+	runningState = "RUNNING"
+)
+
+// connectorStatusChanges describes the changes between two polls of the status of a connector, one line per change.
+// If there is no previous status, the current status is described in full.
+func connectorStatusChanges(previous, current *connectv1.ConnectV1ConnectorExpansionStatus) []string {
+	var lines []string
+
+	state := current.Connector.GetState()
+	if previous == nil {
+		lines = append(lines, fmt.Sprintf(`Connector "%s" is %s.`, current.GetName(), highlightState(state)))
+	} else if previousState := previous.Connector.GetState(); previousState != state {
+		lines = append(lines, fmt.Sprintf(`Connector "%s" changed from %s to %s.`, current.GetName(), highlightState(previousState), highlightState(state)))
+	}
+	if trace := current.Connector.GetTrace(); trace != "" && (previous == nil || previous.Connector.GetTrace() != trace) {
+		lines = append(lines, fmt.Sprintf(`Connector "%s" trace: %s`, current.GetName(), trace))
+	}
+
+	previousTasks := map[int32]connectv1.ConnectV1ConnectorExpansionStatusTasks{}
+	if previous != nil {
+		for _, task := range previous.GetTasks() {
+			previousTasks[task.GetId()] = task
+		}
+	}
+
+	tasks := current.GetTasks()
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].GetId() < tasks[j].GetId() })
+
+	for _, task := range tasks {
+		previousTask, ok := previousTasks[task.GetId()]
+		delete(previousTasks, task.GetId())
+
+		if !ok {
+			lines = append(lines, fmt.Sprintf("Task %d is %s.", task.GetId(), highlightState(task.GetState())))
+		} else if previousTask.GetState() != task.GetState() {
+			lines = append(lines, fmt.Sprintf("Task %d changed from %s to %s.", task.GetId(), highlightState(previousTask.GetState()), highlightState(task.GetState())))
+		}
+		if msg := task.GetMsg(); msg != "" && (!ok || previousTask.GetMsg() != msg) {
+			lines = append(lines, fmt.Sprintf("Task %d trace: %s", task.GetId(), msg))
+		}
+	}
+
+	removed := make([]int, 0, len(previousTasks))
+	for id := range previousTasks {
+		removed = append(removed, int(id))
+	}
+	sort.Ints(removed)
+	for _, id := range removed {
+		lines = append(lines, fmt.Sprintf("Task %d was removed.", id))
+	}
+
+	return lines
+}
+
+func highlightState(state string) string {
+	switch state {
+	case failedState:
+		return color.RedString(state)
+	case runningState:
+		return color.GreenString(state)
+	default:
+		return color.YellowString(state)
+	}
+}
+
+// isFailed returns true if a connector or any of its tasks has failed.
+func isFailed(status *connectv1.ConnectV1ConnectorExpansionStatus) bool {
+	if status.Connector.GetState() == failedState {
+		return true
+	}
+	for _, task := range status.GetTasks() {
+		if task.GetState() == failedState {
+			return true
+		}
+	}
+	return false
+}
+
+func hasTask(tasks []connectv1.ConnectV1ConnectorExpansionStatusTasks, id int32) bool {
+	for _, task := range tasks {
+		if task.GetId() == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package connect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+)
+
+func newStatus(state string, tasks ...connectv1.ConnectV1ConnectorExpansionStatusTasks) *connectv1.ConnectV1ConnectorExpansionStatus {
+	return &connectv1.ConnectV1ConnectorExpansionStatus{
+		Name:      "az-connector",
+		Connector: connectv1.ConnectV1ConnectorExpansionStatusConnector{State: state},
+		Tasks:     &tasks,
+	}
+}
+
+func TestConnectorStatusChanges(t *testing.T) {
+	req := require.New(t)
+
+	provisioning := newStatus("PROVISIONING")
+	req.Equal([]string{`Connector "az-connector" is PROVISIONING.`}, connectorStatusChanges(nil, provisioning))
+	req.Empty(connectorStatusChanges(provisioning, provisioning))
+
+	running := newStatus("RUNNING", connectv1.ConnectV1ConnectorExpansionStatusTasks{Id: 1, State: "RUNNING"}, connectv1.ConnectV1ConnectorExpansionStatusTasks{Id: 0, State: "RUNNING"})
+	req.Equal([]string{
+		`Connector "az-connector" changed from PROVISIONING to RUNNING.`,
+		"Task 0 is RUNNING.",
+		"Task 1 is RUNNING.",
+	}, connectorStatusChanges(provisioning, running))
+
+	failed := newStatus("RUNNING", connectv1.ConnectV1ConnectorExpansionStatusTasks{Id: 0, State: "FAILED", Msg: connectv1.PtrString("org.apache.kafka.connect.errors.ConnectException: Exiting WorkerSinkTask")})
+	req.Equal([]string{
+		"Task 0 changed from RUNNING to FAILED.",
+		"Task 0 trace: org.apache.kafka.connect.errors.ConnectException: Exiting WorkerSinkTask",
+		"Task 1 was removed.",
+	}, connectorStatusChanges(running, failed))
+}
+
+func TestIsFailed(t *testing.T) {
+	req := require.New(t)
+
+	req.False(isFailed(newStatus("RUNNING", connectv1.ConnectV1ConnectorExpansionStatusTasks{Id: 0, State: "RUNNING"})))
+	req.True(isFailed(newStatus("FAILED")))
+	req.True(isFailed(newStatus("RUNNING", connectv1.ConnectV1ConnectorExpansionStatusTasks{Id: 0, State: "FAILED"})))
+}
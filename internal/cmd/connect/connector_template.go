@@ -0,0 +1,116 @@
+package connect
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	v1 "github.com/confluentinc/cli/internal/pkg/config/v1"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/properties"
+)
+
+const configTemplateDescription = "Config values can be templates, so that the same config can be used in every environment and secrets can be kept out of it: " +
+	"`${NAME}` and `${env:NAME}` are replaced with the value of the environment variable NAME, " +
+	"`${file:path}` with the contents of the file at path, `${file:path:key}` with the value of key in the properties file at path, " +
+	"and `${context:property}` with a property of the current context, one of " +
+	"\"environment.id\", \"kafka.cluster.id\", \"kafka.bootstrap\", \"kafka.api.key\", or \"kafka.api.secret\". " +
+	"Relative paths are relative to the directory of the config file. Use `$${` to write a literal `${`."
+
+const onPremConfigTemplateDescription = "Config values are sent to the Connect cluster as is by default, so that placeholders such as `${file:path:key}` are resolved by the config providers of its workers. " +
+	"Set `--resolve-templates` to resolve them before the config is sent instead. " + configTemplateDescription
+
+// templatePlaceholderRegex matches the placeholders of a config template. Placeholders without a prefix are restricted
+// to the upper case names of environment variables, so that placeholders of Single Message Transforms such as `${topic}` are kept.
+var templatePlaceholderRegex = regexp.MustCompile(`\$?\$\{(?:(env|file|context):([^}]+)|([A-Z_][A-Z0-9_]*))}`)
+
+// newTemplateContext returns the properties of the current context which can be referenced by `${context:property}`.
+func newTemplateContext(environmentId string, kafkaCluster *v1.KafkaClusterConfig) map[string]string {
+	templateContext := map[string]string{"environment.id": environmentId}
+	if kafkaCluster == nil {
+		return templateContext
+	}
+
+	templateContext["kafka.cluster.id"] = kafkaCluster.ID
+	templateContext["kafka.bootstrap"] = kafkaCluster.Bootstrap
+	if kafkaCluster.APIKey != "" {
+		templateContext["kafka.api.key"] = kafkaCluster.APIKey
+		if pair, ok := kafkaCluster.APIKeys[kafkaCluster.APIKey]; ok && pair != nil {
+			templateContext["kafka.api.secret"] = pair.Secret
+		}
+	}
+	return templateContext
+}
+
+// resolveTemplate replaces the placeholders of a config value, reading files relative to dir.
+func resolveTemplate(value, dir string, templateContext map[string]string) (string, error) {
+	var resolveErr error
+	resolved := templatePlaceholderRegex.ReplaceAllStringFunc(value, func(placeholder string) string {
+		if strings.HasPrefix(placeholder, "$$") {
+			return placeholder[1:]
+		}
+
+		replacement, err := resolvePlaceholder(templatePlaceholderRegex.FindStringSubmatch(placeholder), dir, templateContext)
+		if err != nil && resolveErr == nil {
+			resolveErr = err
+		}
+		return replacement
+	})
+	return resolved, resolveErr
+}
+
+// wrapTemplateError adds the config and file of a placeholder which cannot be resolved to its error, keeping its suggestions.
+func wrapTemplateError(err error, key, path string) error {
+	errorMsg := fmt.Sprintf(errors.ResolveConfigTemplateErrorMsg, key, path, err)
+	if errWithSuggestions, ok := err.(errors.ErrorWithSuggestions); ok {
+		return errors.NewErrorWithSuggestions(errorMsg, errWithSuggestions.GetSuggestionsMsg())
+	}
+	return errors.New(errorMsg)
+}
+
+func resolvePlaceholder(match []string, dir string, templateContext map[string]string) (string, error) {
+	switch match[1] {
+	case "", "env":
+		variable := match[2] + match[3]
+		value, ok := os.LookupEnv(variable)
+		if !ok {
+			return "", errors.NewErrorWithSuggestions(fmt.Sprintf(errors.UnsetEnvVariableErrorMsg, variable), fmt.Sprintf(errors.UnsetEnvVariableSuggestions, variable))
+		}
+		return value, nil
+	case "file":
+		// The key follows the last colon, unless it is part of a Windows path such as "C:\secrets".
+		path, key := match[2], ""
+		if i := strings.LastIndex(path, ":"); i != -1 && !strings.ContainsAny(path[i+1:], `/\`) {
+			path, key = path[:i], path[i+1:]
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		if key == "" {
+			contents, err := os.ReadFile(path)
+			if err != nil {
+				return "", err
+			}
+			return strings.TrimRight(string(contents), "\r\n"), nil
+		}
+
+		configs, err := properties.FileToMap(path)
+		if err != nil {
+			return "", err
+		}
+		value, ok := configs[key]
+		if !ok {
+			return "", errors.Errorf(errors.MissingPropertiesFileKeyErrorMsg, key, path)
+		}
+		return value, nil
+	default:
+		value, ok := templateContext[match[2]]
+		if !ok || value == "" {
+			return "", errors.NewErrorWithSuggestions(fmt.Sprintf(errors.UnsetContextPropertyErrorMsg, match[2]), errors.UnsetContextPropertySuggestions)
+		}
+		return value, nil
+	}
+}
@@ -0,0 +1,78 @@
+package connect
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/confluentinc/cli/internal/pkg/config/v1"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+)
+
+func TestResolveTemplate(t *testing.T) {
+	req := require.New(t)
+
+	t.Setenv("DATABASE_HOST", "db.prod.example.com")
+
+	dir := t.TempDir()
+	req.NoError(os.WriteFile(filepath.Join(dir, "password"), []byte("hunter2\n"), 0600))
+	req.NoError(os.WriteFile(filepath.Join(dir, "prod.properties"), []byte("# Production\ndatabase.user=orders\n"), 0600))
+
+	templateContext := newTemplateContext("env-123456", &v1.KafkaClusterConfig{
+		ID:        "lkc-123456",
+		Bootstrap: "SASL_SSL://pkc-12345.us-west-2.aws.confluent.cloud:9092",
+		APIKey:    "ABCDEFGHIJKLMNOP",
+		APIKeys:   map[string]*v1.APIKeyPair{"ABCDEFGHIJKLMNOP": {Key: "ABCDEFGHIJKLMNOP", Secret: "secret"}},
+	})
+
+	for template, expected := range map[string]string{
+		"${DATABASE_HOST}": "db.prod.example.com",
+		"jdbc:postgresql://${env:DATABASE_HOST}:5432/orders": "jdbc:postgresql://db.prod.example.com:5432/orders",
+		"${file:password}":                                     "hunter2",
+		"${file:prod.properties:database.user}":                "orders",
+		"${context:kafka.bootstrap}":                           "SASL_SSL://pkc-12345.us-west-2.aws.confluent.cloud:9092",
+		"${context:kafka.api.key}:${context:kafka.api.secret}": "ABCDEFGHIJKLMNOP:secret",
+		"${topic}-${timestamp}":                                "${topic}-${timestamp}",
+		"$${DATABASE_HOST}":                                    "${DATABASE_HOST}",
+	} {
+		resolved, err := resolveTemplate(template, dir, templateContext)
+		req.NoError(err)
+		req.Equal(expected, resolved, template)
+	}
+
+	_, err := resolveTemplate("${DATABASE_PORT}", dir, templateContext)
+	errors.VerifyErrorAndSuggestions(req, err, `environment variable "DATABASE_PORT" is not set`, "Set the environment variable, or escape the placeholder as `$${DATABASE_PORT}` to keep it as is.")
+
+	err = wrapTemplateError(err, "connection.url", "config.json")
+	errors.VerifyErrorAndSuggestions(req, err, `unable to resolve the placeholders of config "connection.url" in "config.json": environment variable "DATABASE_PORT" is not set`, "Set the environment variable, or escape the placeholder as `$${DATABASE_PORT}` to keep it as is.")
+
+	_, err = resolveTemplate("${file:prod.properties:database.password}", dir, templateContext)
+	req.EqualError(err, `key "database.password" not found in properties file "`+filepath.Join(dir, "prod.properties")+`"`)
+
+	_, err = resolveTemplate("${context:kafka.bootstrap}", dir, nil)
+	req.EqualError(err, `context property "kafka.bootstrap" is not set`)
+}
+
+func TestGetOnPremConfig(t *testing.T) {
+	req := require.New(t)
+
+	// The worker resolves this placeholder with its FileConfigProvider, so the file only exists on the worker.
+	placeholder := "${file:/etc/kafka/secrets.properties:password}"
+	configFile := filepath.Join(t.TempDir(), "config.json")
+	req.NoError(os.WriteFile(configFile, []byte(`{"name": "jdbc-sink", "connector.class": "JdbcSink", "connection.password": "`+placeholder+`"}`), 0600))
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("config-file", configFile, "")
+	cmd.Flags().Bool("resolve-templates", false, "")
+
+	configs, err := getOnPremConfig(cmd)
+	req.NoError(err)
+	req.Equal(placeholder, (*configs)["connection.password"])
+
+	req.NoError(cmd.Flags().Set("resolve-templates", "true"))
+	_, err = getOnPremConfig(cmd)
+	req.Error(err)
+}
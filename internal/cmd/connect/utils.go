@@ -3,6 +3,7 @@ package connect
 import (
 	"encoding/json"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 
@@ -15,24 +16,60 @@ const (
 	connectorClass = "connector.class"
 )
 
-func getConfig(cmd *cobra.Command) (*map[string]string, error) {
-	configFile, err := cmd.Flags().GetString("config-file")
+// getConfig reads the connector config file of a command and resolves its template placeholders.
+func getConfig(cmd *cobra.Command, templateContext map[string]string) (*map[string]string, error) {
+	configFile, options, err := readConfigFile(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range options {
+		options[key], err = resolveTemplate(value, filepath.Dir(configFile), templateContext)
+		if err != nil {
+			return nil, wrapTemplateError(err, key, configFile)
+		}
+	}
+
+	return &options, nil
+}
+
+// getOnPremConfig reads the connector config file of a command. Its template placeholders are only resolved if `--resolve-templates` is set,
+// since the workers of a self-managed Connect cluster resolve placeholders of the same syntax with their config providers.
+func getOnPremConfig(cmd *cobra.Command) (*map[string]string, error) {
+	resolveTemplates, err := cmd.Flags().GetBool("resolve-templates")
 	if err != nil {
 		return nil, err
 	}
+	if resolveTemplates {
+		return getConfig(cmd, nil)
+	}
+
+	_, options, err := readConfigFile(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return &options, nil
+}
+
+// readConfigFile reads the connector config file of a command, leaving its template placeholders as is.
+func readConfigFile(cmd *cobra.Command) (string, map[string]string, error) {
+	configFile, err := cmd.Flags().GetString("config-file")
+	if err != nil {
+		return "", nil, err
+	}
 
 	options, err := parseConfigFile(configFile)
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to parse config %s", configFile)
+		return "", nil, errors.Wrapf(err, "unable to parse config %s", configFile)
 	}
 
 	_, nameExists := options[name]
 	_, classExists := options[connectorClass]
 	if !nameExists || !classExists {
-		return nil, errors.Errorf(errors.MissingRequiredConfigsErrorMsg, configFile)
+		return "", nil, errors.Errorf(errors.MissingRequiredConfigsErrorMsg, configFile)
 	}
 
-	return &options, nil
+	return configFile, options, nil
 }
 
 func parseConfigFile(fileName string) (map[string]string, error) {
@@ -44,13 +81,19 @@ func parseConfigFile(fileName string) (map[string]string, error) {
 		return nil, errors.Errorf(errors.EmptyConfigFileErrorMsg, fileName)
 	}
 
-	kvPairs := make(map[string]string)
 	var options map[string]interface{}
 
 	if err := json.Unmarshal(jsonFile, &options); err != nil {
 		return nil, errors.Wrapf(err, errors.ParseConfigErrorMsg, fileName)
 	}
 
+	return toConnectorConfig(options)
+}
+
+// toConnectorConfig flattens the options of a connector config file, which are either
+// a map of configs, or a "name" and a map of configs under the "config" key.
+func toConnectorConfig(options map[string]interface{}) (map[string]string, error) {
+	kvPairs := make(map[string]string)
 	for key, val := range options {
 		if val2, ok := val.(string); ok {
 			kvPairs[key] = val2
@@ -75,5 +118,5 @@ func parseConfigFile(fileName string) (map[string]string, error) {
 		}
 	}
 
-	return kvPairs, err
+	return kvPairs, nil
 }
@@ -19,7 +19,7 @@ func New(cfg *v1.Config, prerunner pcmd.PreRunner, clientID string) *cobra.Comma
 	cmd.AddCommand(newBrokerCommand(prerunner))
 	cmd.AddCommand(newClientConfigCommand(prerunner, clientID))
 	cmd.AddCommand(newClusterCommand(cfg, prerunner))
-	cmd.AddCommand(newConsumerGroupCommand(prerunner))
+	cmd.AddCommand(newConsumerGroupCommand(prerunner, clientID))
 	cmd.AddCommand(newLinkCommand(cfg, prerunner))
 	cmd.AddCommand(newMirrorCommand(prerunner))
 	cmd.AddCommand(newPartitionCommand(prerunner))
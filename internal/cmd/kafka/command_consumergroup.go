@@ -10,6 +10,7 @@ import (
 
 type consumerGroupCommand struct {
 	*pcmd.AuthenticatedStateFlagCommand
+	clientID string
 }
 
 type consumerData struct {
@@ -38,7 +39,7 @@ type consumerGroupOut struct {
 	State             string `human:"State" serialized:"state"`
 }
 
-func newConsumerGroupCommand(prerunner pcmd.PreRunner) *cobra.Command {
+func newConsumerGroupCommand(prerunner pcmd.PreRunner, clientID string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:         "consumer-group",
 		Aliases:     []string{"cg"},
@@ -47,11 +48,15 @@ func newConsumerGroupCommand(prerunner pcmd.PreRunner) *cobra.Command {
 		Hidden:      true,
 	}
 
-	c := &consumerGroupCommand{pcmd.NewAuthenticatedStateFlagCommand(cmd, prerunner)}
+	c := &consumerGroupCommand{
+		AuthenticatedStateFlagCommand: pcmd.NewAuthenticatedStateFlagCommand(cmd, prerunner),
+		clientID:                      clientID,
+	}
 
 	cmd.AddCommand(c.newDescribeCommand())
 	cmd.AddCommand(newLagCommand(prerunner))
 	cmd.AddCommand(c.newListCommand())
+	cmd.AddCommand(c.newResetOffsetsCommand())
 
 	return cmd
 }
@@ -0,0 +1,420 @@
+package kafka
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/kafkarest"
+	"github.com/confluentinc/cli/internal/pkg/log"
+	"github.com/confluentinc/cli/internal/pkg/output"
+)
+
+const resetOffsetsTimeoutMs = 10000
+
+var (
+	resetOffsetsStrategyFlags = []string{"to-earliest", "to-latest", "to-offset", "to-timestamp", "shift-by", "from-file"}
+	resetOffsetsScopeFlags    = []string{"all-topics", "topic", "from-file"}
+)
+
+type resetOffsetsOut struct {
+	Topic         string `human:"Topic" serialized:"topic"`
+	Partition     int32  `human:"Partition" serialized:"partition"`
+	CurrentOffset int64  `human:"Current Offset" serialized:"current_offset"`
+	NewOffset     int64  `human:"New Offset" serialized:"new_offset"`
+}
+
+// resetOffsetsStrategy computes the offset each partition of a consumer group is reset to.
+type resetOffsetsStrategy struct {
+	flag    string
+	offset  int64                      // The offset of "to-offset", or the shift of "shift-by".
+	offsets map[string]map[int32]int64 // The offset of each partition for "to-timestamp" and "from-file".
+}
+
+func (c *consumerGroupCommand) newResetOffsetsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "reset-offsets <consumer-group>",
+		Short:             "Reset the offsets of a Kafka consumer group.",
+		Long:              "Reset the committed offsets of a Kafka consumer group, which must not have any active members.\n\nNew offsets are limited to the range of offsets in each partition.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
+		RunE:              c.resetOffsets,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Preview resetting the offsets of consumer group "my-consumer-group" to the beginning of every topic it consumes.`,
+				Code: "confluent kafka consumer-group reset-offsets my-consumer-group --all-topics --to-earliest --dry-run",
+			},
+			examples.Example{
+				Text: `Reset the offsets of consumer group "my-consumer-group" for partitions 0 and 1 of topic "my-topic" to the first messages produced after 2023-01-01.`,
+				Code: "confluent kafka consumer-group reset-offsets my-consumer-group --topic my-topic:0,1 --to-timestamp 2023-01-01T00:00:00Z",
+			},
+			examples.Example{
+				Text: `Skip the next 100 messages of topic "my-topic" for consumer group "my-consumer-group".`,
+				Code: "confluent kafka consumer-group reset-offsets my-consumer-group --topic my-topic --shift-by 100",
+			},
+			examples.Example{
+				Text: `Reset the offsets of consumer group "my-consumer-group" to those in a CSV file, formatted as "<topic>,<partition>,<offset>" on each line.`,
+				Code: "confluent kafka consumer-group reset-offsets my-consumer-group --from-file offsets.csv",
+			},
+		),
+		Hidden: true,
+	}
+
+	cmd.Flags().Bool("all-topics", false, "Reset offsets for every topic the consumer group has committed offsets for.")
+	cmd.Flags().StringArray("topic", nil, `Reset offsets for this topic, formatted as "<topic>" or "<topic>:<partitions>", where partitions is a comma-separated list of partition IDs. May be repeated.`)
+	cmd.Flags().Bool("to-earliest", false, "Reset offsets to the beginning of each partition.")
+	cmd.Flags().Bool("to-latest", false, "Reset offsets to the end of each partition.")
+	cmd.Flags().Int64("to-offset", 0, "Reset offsets to this offset.")
+	cmd.Flags().String("to-timestamp", "", `Reset offsets to the first message at or after this timestamp, in RFC 3339 format (for example, "2006-01-02T15:04:05Z") or milliseconds since the epoch.`)
+	cmd.Flags().Int64("shift-by", 0, "Shift offsets by this number of messages, which may be negative.")
+	cmd.Flags().String("from-file", "", `Reset offsets to those in a CSV file, formatted as "<topic>,<partition>,<offset>" on each line.`)
+	cmd.Flags().Bool("dry-run", false, "Print the new offsets without resetting them.")
+	cmd.Flags().String("api-key", "", "API key.")
+	cmd.Flags().String("api-secret", "", "API key secret.")
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *consumerGroupCommand) resetOffsets(cmd *cobra.Command, args []string) error {
+	consumerGroupId := args[0]
+
+	if err := checkExactlyOneFlag(cmd, resetOffsetsStrategyFlags); err != nil {
+		return err
+	}
+	if err := checkExactlyOneFlag(cmd, resetOffsetsScopeFlags); err != nil {
+		return err
+	}
+
+	strategy, err := getResetOffsetsStrategy(cmd)
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	kafkaREST, lkc, err := getKafkaRestProxyAndLkcId(c.AuthenticatedStateFlagCommand)
+	if err != nil {
+		return err
+	}
+
+	consumers, httpResp, err := kafkaREST.CloudClient.ListKafkaConsumers(lkc, consumerGroupId)
+	if err != nil {
+		return kafkarest.NewError(kafkaREST.CloudClient.GetUrl(), err, httpResp)
+	}
+	if len(consumers.Data) > 0 && !dryRun {
+		return errors.NewErrorWithSuggestions(fmt.Sprintf(errors.ConsumerGroupHasActiveMembersErrorMsg, consumerGroupId, len(consumers.Data)), errors.ConsumerGroupHasActiveMembersSuggestions)
+	}
+
+	var partitions []ckafka.TopicPartition
+	if allTopics, err := cmd.Flags().GetBool("all-topics"); err != nil {
+		return err
+	} else if allTopics {
+		lags, httpResp, err := kafkaREST.CloudClient.ListKafkaConsumerLags(lkc, consumerGroupId)
+		if err != nil {
+			return kafkarest.NewError(kafkaREST.CloudClient.GetUrl(), err, httpResp)
+		}
+		for _, lag := range lags.Data {
+			topic := lag.TopicName
+			partitions = append(partitions, ckafka.TopicPartition{Topic: &topic, Partition: lag.PartitionId})
+		}
+	}
+
+	cluster, err := c.Context.GetKafkaClusterForCommand()
+	if err != nil {
+		return err
+	}
+	apiKey, err := cmd.Flags().GetString("api-key")
+	if err != nil {
+		return err
+	}
+	apiSecret, err := cmd.Flags().GetString("api-secret")
+	if err != nil {
+		return err
+	}
+	cluster, err = withApiKey(cluster, apiKey, apiSecret)
+	if err != nil {
+		return err
+	}
+
+	// The consumer commits offsets on behalf of the group, without joining it.
+	consumer, err := newConsumer(consumerGroupId, cluster, c.clientID, "", nil)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateConsumerErrorMsg, err)
+	}
+	defer consumer.Close()
+	log.CliLogger.Trace("Create consumer succeeded")
+
+	if topics, err := cmd.Flags().GetStringArray("topic"); err != nil {
+		return err
+	} else if len(topics) > 0 {
+		partitions, err = getTopicPartitions(consumer, topics)
+		if err != nil {
+			return err
+		}
+	}
+	if strategy.flag == "from-file" {
+		for topic, offsets := range strategy.offsets {
+			for partition := range offsets {
+				topic := topic
+				partitions = append(partitions, ckafka.TopicPartition{Topic: &topic, Partition: partition})
+			}
+		}
+	}
+	sortTopicPartitions(partitions)
+
+	if strategy.flag == "to-timestamp" {
+		if err := strategy.setOffsetsForTimestamp(consumer, partitions); err != nil {
+			return err
+		}
+	}
+
+	committed, err := consumer.Committed(partitions, resetOffsetsTimeoutMs)
+	if err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	offsets := make([]ckafka.TopicPartition, len(committed))
+	for i, partition := range committed {
+		low, high, err := consumer.QueryWatermarkOffsets(*partition.Topic, partition.Partition, resetOffsetsTimeoutMs)
+		if err != nil {
+			return err
+		}
+
+		current := int64(partition.Offset)
+		if partition.Offset < 0 {
+			current = -1
+		}
+		newOffset := strategy.getNewOffset(*partition.Topic, partition.Partition, current, low, high)
+
+		offsets[i] = ckafka.TopicPartition{Topic: partition.Topic, Partition: partition.Partition, Offset: ckafka.Offset(newOffset)}
+		list.Add(&resetOffsetsOut{
+			Topic:         *partition.Topic,
+			Partition:     partition.Partition,
+			CurrentOffset: current,
+			NewOffset:     newOffset,
+		})
+	}
+
+	if !dryRun && len(offsets) > 0 {
+		committed, err := consumer.CommitOffsets(offsets)
+		if err != nil {
+			return errors.Wrapf(err, errors.FailedToResetOffsetsErrorMsg, consumerGroupId)
+		}
+		for _, partition := range committed {
+			if partition.Error != nil {
+				return errors.Wrapf(partition.Error, errors.FailedToResetOffsetsErrorMsg, consumerGroupId)
+			}
+		}
+	}
+
+	return list.Print()
+}
+
+func checkExactlyOneFlag(cmd *cobra.Command, flags []string) error {
+	count := 0
+	for _, flag := range flags {
+		if cmd.Flags().Changed(flag) {
+			count++
+		}
+	}
+	if count != 1 {
+		return errors.Errorf(errors.ExactlyOneSetErrorMsg, strings.Join(flags, ", "))
+	}
+	return nil
+}
+
+func getResetOffsetsStrategy(cmd *cobra.Command) (*resetOffsetsStrategy, error) {
+	strategy := &resetOffsetsStrategy{}
+	for _, flag := range resetOffsetsStrategyFlags {
+		if cmd.Flags().Changed(flag) {
+			strategy.flag = flag
+		}
+	}
+
+	var err error
+	switch strategy.flag {
+	case "to-offset":
+		strategy.offset, err = cmd.Flags().GetInt64("to-offset")
+		if err == nil && strategy.offset < 0 {
+			err = errors.New(errors.InvalidOffsetErrorMsg)
+		}
+	case "shift-by":
+		strategy.offset, err = cmd.Flags().GetInt64("shift-by")
+	case "to-timestamp":
+		var value string
+		value, err = cmd.Flags().GetString("to-timestamp")
+		if err == nil {
+			strategy.offset, err = parseTimestamp(value)
+		}
+	case "from-file":
+		var path string
+		path, err = cmd.Flags().GetString("from-file")
+		if err == nil {
+			strategy.offsets, err = readOffsetsFile(path)
+		}
+	}
+	return strategy, err
+}
+
+// setOffsetsForTimestamp looks up the offset of the first message at or after the strategy's timestamp in each partition.
+func (s *resetOffsetsStrategy) setOffsetsForTimestamp(consumer *ckafka.Consumer, partitions []ckafka.TopicPartition) error {
+	times := make([]ckafka.TopicPartition, len(partitions))
+	for i, partition := range partitions {
+		times[i] = ckafka.TopicPartition{Topic: partition.Topic, Partition: partition.Partition, Offset: ckafka.Offset(s.offset)}
+	}
+
+	offsets, err := consumer.OffsetsForTimes(times, resetOffsetsTimeoutMs)
+	if err != nil {
+		return err
+	}
+
+	s.offsets = map[string]map[int32]int64{}
+	for _, partition := range offsets {
+		if s.offsets[*partition.Topic] == nil {
+			s.offsets[*partition.Topic] = map[int32]int64{}
+		}
+		// Partitions without a message at or after the timestamp are reset to their end.
+		offset := int64(partition.Offset)
+		if offset < 0 {
+			offset = math.MaxInt64
+		}
+		s.offsets[*partition.Topic][partition.Partition] = offset
+	}
+	return nil
+}
+
+// getNewOffset returns the offset a partition is reset to, limited to the partition's low and high watermarks.
+// The current offset is -1 if the consumer group has not committed an offset for the partition.
+func (s *resetOffsetsStrategy) getNewOffset(topic string, partition int32, current, low, high int64) int64 {
+	var offset int64
+	switch s.flag {
+	case "to-earliest":
+		offset = low
+	case "to-latest":
+		offset = high
+	case "to-offset":
+		offset = s.offset
+	case "shift-by":
+		if current < 0 {
+			current = low
+		}
+		offset = current + s.offset
+	case "to-timestamp", "from-file":
+		offset = s.offsets[topic][partition]
+	}
+
+	if offset < low {
+		return low
+	}
+	if offset > high {
+		return high
+	}
+	return offset
+}
+
+// getTopicPartitions parses topics formatted as "<topic>" or "<topic>:<partitions>", looking up every partition of a topic if none are given.
+func getTopicPartitions(consumer *ckafka.Consumer, topics []string) ([]ckafka.TopicPartition, error) {
+	var partitions []ckafka.TopicPartition
+	for _, value := range topics {
+		topic, partitionIds, hasPartitions := strings.Cut(value, ":")
+		topic = strings.TrimSpace(topic)
+		if topic == "" {
+			return nil, errors.Errorf(errors.InvalidTopicPartitionsErrorMsg, value)
+		}
+
+		if hasPartitions {
+			for _, id := range strings.Split(partitionIds, ",") {
+				partition, err := strconv.ParseInt(strings.TrimSpace(id), 10, 32)
+				if err != nil || partition < 0 {
+					return nil, errors.Errorf(errors.InvalidTopicPartitionsErrorMsg, value)
+				}
+				partitions = append(partitions, ckafka.TopicPartition{Topic: &topic, Partition: int32(partition)})
+			}
+			continue
+		}
+
+		metadata, err := consumer.GetMetadata(&topic, false, resetOffsetsTimeoutMs)
+		if err != nil {
+			return nil, err
+		}
+		topicMetadata, ok := metadata.Topics[topic]
+		if !ok || topicMetadata.Error.Code() == ckafka.ErrUnknownTopicOrPart {
+			return nil, errors.Errorf(errors.UnknownTopicErrorMsg, topic)
+		}
+		for _, partition := range topicMetadata.Partitions {
+			partitions = append(partitions, ckafka.TopicPartition{Topic: &topic, Partition: partition.ID})
+		}
+	}
+	return partitions, nil
+}
+
+// readOffsetsFile reads a CSV file of offsets, formatted as "<topic>,<partition>,<offset>" on each line.
+func readOffsetsFile(path string) (map[string]map[int32]int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return readOffsets(file)
+}
+
+func readOffsets(r io.Reader) (map[string]map[int32]int64, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	offsets := map[string]map[int32]int64{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InvalidOffsetsFileErrorMsg)
+		}
+
+		partition, err := strconv.ParseInt(record[1], 10, 32)
+		if err != nil || partition < 0 {
+			return nil, errors.Errorf(errors.InvalidOffsetsFileRecordErrorMsg, strings.Join(record, ","))
+		}
+		offset, err := strconv.ParseInt(record[2], 10, 64)
+		if err != nil || offset < 0 {
+			return nil, errors.Errorf(errors.InvalidOffsetsFileRecordErrorMsg, strings.Join(record, ","))
+		}
+
+		if offsets[record[0]] == nil {
+			offsets[record[0]] = map[int32]int64{}
+		}
+		offsets[record[0]][int32(partition)] = offset
+	}
+	return offsets, nil
+}
+
+func sortTopicPartitions(partitions []ckafka.TopicPartition) {
+	sort.Slice(partitions, func(i, j int) bool {
+		if *partitions[i].Topic != *partitions[j].Topic {
+			return *partitions[i].Topic < *partitions[j].Topic
+		}
+		return partitions[i].Partition < partitions[j].Partition
+	})
+}
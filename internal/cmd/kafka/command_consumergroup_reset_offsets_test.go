@@ -0,0 +1,68 @@
+package kafka
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResetOffsetsStrategy_GetNewOffset(t *testing.T) {
+	req := require.New(t)
+
+	tests := []struct {
+		strategy *resetOffsetsStrategy
+		current  int64
+		expected int64
+	}{
+		{&resetOffsetsStrategy{flag: "to-earliest"}, 50, 10},
+		{&resetOffsetsStrategy{flag: "to-latest"}, 50, 100},
+		{&resetOffsetsStrategy{flag: "to-offset", offset: 42}, 50, 42},
+		{&resetOffsetsStrategy{flag: "to-offset", offset: 500}, 50, 100},
+		{&resetOffsetsStrategy{flag: "shift-by", offset: -20}, 50, 30},
+		{&resetOffsetsStrategy{flag: "shift-by", offset: -100}, 50, 10},
+		{&resetOffsetsStrategy{flag: "shift-by", offset: 5}, -1, 15},
+		{&resetOffsetsStrategy{flag: "to-timestamp", offsets: map[string]map[int32]int64{"my-topic": {0: math.MaxInt64}}}, 50, 100},
+		{&resetOffsetsStrategy{flag: "from-file", offsets: map[string]map[int32]int64{"my-topic": {0: 60}}}, 50, 60},
+	}
+
+	for _, test := range tests {
+		req.Equal(test.expected, test.strategy.getNewOffset("my-topic", 0, test.current, 10, 100), test.strategy.flag)
+	}
+}
+
+func TestReadOffsets(t *testing.T) {
+	req := require.New(t)
+
+	offsets, err := readOffsets(strings.NewReader("my-topic,0,10\nmy-topic, 1, 20\nother-topic,0,5\n"))
+	req.NoError(err)
+	req.Equal(map[string]map[int32]int64{
+		"my-topic":    {0: 10, 1: 20},
+		"other-topic": {0: 5},
+	}, offsets)
+
+	_, err = readOffsets(strings.NewReader("my-topic,0,-1\n"))
+	req.EqualError(err, `invalid line "my-topic,0,-1" in offsets file: must be formatted as "<topic>,<partition>,<offset>"`)
+
+	_, err = readOffsets(strings.NewReader("my-topic,0\n"))
+	req.Error(err)
+}
+
+func TestCheckExactlyOneFlag(t *testing.T) {
+	req := require.New(t)
+
+	cmd := &cobra.Command{}
+	cmd.Flags().Bool("all-topics", false, "")
+	cmd.Flags().StringArray("topic", nil, "")
+	cmd.Flags().String("from-file", "", "")
+
+	req.EqualError(checkExactlyOneFlag(cmd, resetOffsetsScopeFlags), "exactly one of all-topics, topic, from-file must be set")
+
+	req.NoError(cmd.Flags().Set("topic", "my-topic"))
+	req.NoError(checkExactlyOneFlag(cmd, resetOffsetsScopeFlags))
+
+	req.NoError(cmd.Flags().Set("from-file", "offsets.csv"))
+	req.Error(checkExactlyOneFlag(cmd, resetOffsetsScopeFlags))
+}
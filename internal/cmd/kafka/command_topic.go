@@ -43,10 +43,16 @@ func newTopicCommand(cfg *v1.Config, prerunner pcmd.PreRunner, clientID string)
 	if cfg.IsCloudLogin() {
 		c.AuthenticatedStateFlagCommand = pcmd.NewAuthenticatedStateFlagCommand(cmd, prerunner)
 
+		cmd.AddCommand(c.newApplyCommand())
 		cmd.AddCommand(newConsumeCommand(prerunner, clientID))
+		cmd.AddCommand(newCopyCommand(prerunner, clientID))
 		cmd.AddCommand(c.newCreateCommand())
 		cmd.AddCommand(c.newDeleteCommand())
 		cmd.AddCommand(c.newDescribeCommand())
+		cmd.AddCommand(c.newDiffCommand())
+		cmd.AddCommand(newExportCommand(prerunner, clientID))
+		cmd.AddCommand(newGenerateCommand(prerunner, clientID))
+		cmd.AddCommand(newImportCommand(prerunner, clientID))
 		cmd.AddCommand(c.newListCommand())
 		cmd.AddCommand(newProduceCommand(prerunner, clientID))
 		cmd.AddCommand(c.newUpdateCommand())
@@ -54,6 +60,7 @@ func newTopicCommand(cfg *v1.Config, prerunner pcmd.PreRunner, clientID string)
 		c.AuthenticatedStateFlagCommand = pcmd.NewAuthenticatedWithMDSStateFlagCommand(cmd, prerunner)
 		c.PersistentPreRunE = prerunner.InitializeOnPremKafkaRest(c.AuthenticatedCLICommand)
 
+		cmd.AddCommand(c.newApplyCommandOnPrem())
 		cmd.AddCommand(c.newConsumeCommandOnPrem())
 		cmd.AddCommand(c.newCreateCommandOnPrem())
 		cmd.AddCommand(c.newDeleteCommandOnPrem())
@@ -0,0 +1,143 @@
+package kafka
+
+import (
+	"fmt"
+
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/internal/pkg/ccloudv2"
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/kafkarest"
+)
+
+type cloudTopicApplyClient struct {
+	kafkaREST *pcmd.KafkaREST
+	clusterId string
+}
+
+func (c *authenticatedTopicCommand) newApplyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Create and update Kafka topics from a file.",
+		Long:  topicApplyLongDescription,
+		Args:  cobra.NoArgs,
+		RunE:  c.apply,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Print the changes needed for the topics of the current cluster to match "topics.yaml".`,
+				Code: "confluent kafka topic apply --file topics.yaml --dry-run",
+			},
+			examples.Example{
+				Text: `Create and update topics to match "topics.yaml", and delete the topics which are not in it.`,
+				Code: "confluent kafka topic apply --file topics.yaml --prune",
+			},
+		),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
+	}
+
+	addTopicApplyFlags(cmd)
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *authenticatedTopicCommand) apply(cmd *cobra.Command, _ []string) error {
+	kafkaClusterConfig, err := c.Context.GetKafkaClusterForCommand()
+	if err != nil {
+		return err
+	}
+
+	if err := c.provisioningClusterCheck(kafkaClusterConfig.ID); err != nil {
+		return err
+	}
+
+	kafkaREST, err := c.GetKafkaREST()
+	if err != nil {
+		return err
+	}
+
+	return runTopicApply(cmd, &cloudTopicApplyClient{kafkaREST: kafkaREST, clusterId: kafkaClusterConfig.ID})
+}
+
+func (c *cloudTopicApplyClient) listTopics() (map[string]*topicState, error) {
+	topics, httpResp, err := c.kafkaREST.CloudClient.ListKafkaTopics(c.clusterId)
+	if err != nil {
+		return nil, kafkarest.NewError(c.kafkaREST.CloudClient.GetUrl(), err, httpResp)
+	}
+
+	states := make(map[string]*topicState, len(topics.Data))
+	for _, topic := range topics.Data {
+		states[topic.GetTopicName()] = &topicState{
+			partitions:        topic.GetPartitionsCount(),
+			replicationFactor: topic.GetReplicationFactor(),
+			isInternal:        topic.GetIsInternal(),
+		}
+	}
+	return states, nil
+}
+
+func (c *cloudTopicApplyClient) listTopicConfigs(topic string) (map[string]*topicConfig, error) {
+	configsResp, err := c.kafkaREST.CloudClient.ListKafkaTopicConfigs(c.clusterId, topic)
+	if err != nil {
+		return nil, kafkarest.NewError(c.kafkaREST.CloudClient.GetUrl(), err, nil)
+	}
+
+	configs := make(map[string]*topicConfig, len(configsResp.Data))
+	for _, config := range configsResp.Data {
+		configs[config.Name] = &topicConfig{value: config.GetValue(), isDefault: config.IsDefault}
+	}
+	return configs, nil
+}
+
+func (c *cloudTopicApplyClient) createTopic(spec *topicSpec) error {
+	topicConfigs := make([]kafkarestv3.CreateTopicRequestDataConfigs, 0, len(spec.Configs))
+	for _, name := range sortedKeys(spec.Configs) {
+		value := spec.Configs[name]
+		topicConfigs = append(topicConfigs, kafkarestv3.CreateTopicRequestDataConfigs{
+			Name:  name,
+			Value: *kafkarestv3.NewNullableString(&value),
+		})
+	}
+
+	data := kafkarestv3.CreateTopicRequestData{
+		TopicName: spec.Name,
+		Configs:   &topicConfigs,
+	}
+	if spec.Partitions != 0 {
+		data.PartitionsCount = &spec.Partitions
+	}
+	if spec.ReplicationFactor != 0 {
+		data.ReplicationFactor = &spec.ReplicationFactor
+	}
+
+	_, httpResp, err := c.kafkaREST.CloudClient.CreateKafkaTopic(c.clusterId, data)
+	if err != nil {
+		return kafkarest.NewError(c.kafkaREST.CloudClient.GetUrl(), err, httpResp)
+	}
+	return nil
+}
+
+func (c *cloudTopicApplyClient) updateTopicConfigs(topic string, configs map[string]string) error {
+	httpResp, err := c.kafkaREST.CloudClient.UpdateKafkaTopicConfigBatch(c.clusterId, topic, toAlterConfigBatchRequestData(configs))
+	if err != nil {
+		return kafkarest.NewError(c.kafkaREST.CloudClient.GetUrl(), err, httpResp)
+	}
+	return nil
+}
+
+func (c *cloudTopicApplyClient) deleteTopic(topic string) error {
+	httpResp, err := c.kafkaREST.CloudClient.DeleteKafkaTopic(c.clusterId, topic)
+	if err != nil {
+		if restErr, parseErr := kafkarest.ParseOpenAPIErrorCloud(err); parseErr == nil && restErr.Code == ccloudv2.UnknownTopicOrPartitionErrorCode {
+			return fmt.Errorf(errors.UnknownTopicErrorMsg, topic)
+		}
+		return kafkarest.NewError(c.kafkaREST.CloudClient.GetUrl(), err, httpResp)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/antihax/optional"
+	"github.com/confluentinc/kafka-rest-sdk-go/kafkarestv3"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/kafkarest"
+)
+
+type onPremTopicApplyClient struct {
+	restClient  *kafkarestv3.APIClient
+	restContext context.Context
+	clusterId   string
+}
+
+func (c *authenticatedTopicCommand) newApplyCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Create and update Kafka topics from a file.",
+		Long:  topicApplyLongDescription,
+		Args:  cobra.NoArgs,
+		RunE:  c.onPremApply,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Print the changes needed for the topics of the specified cluster (providing Kafka REST Proxy endpoint) to match "topics.yaml".`,
+				Code: "confluent kafka topic apply --url http://localhost:8082 --file topics.yaml --dry-run",
+			},
+			examples.Example{
+				Text: `Create and update topics to match "topics.yaml", and delete the topics which are not in it.`,
+				Code: "confluent kafka topic apply --url http://localhost:8082 --file topics.yaml --prune",
+			},
+		),
+	}
+
+	addTopicApplyFlags(cmd)
+	cmd.Flags().AddFlagSet(pcmd.OnPremKafkaRestSet())
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *authenticatedTopicCommand) onPremApply(cmd *cobra.Command, _ []string) error {
+	restClient, restContext, err := initKafkaRest(c.AuthenticatedCLICommand, cmd)
+	if err != nil {
+		return err
+	}
+
+	clusterId, err := getClusterIdForRestRequests(restClient, restContext)
+	if err != nil {
+		return err
+	}
+
+	return runTopicApply(cmd, &onPremTopicApplyClient{restClient: restClient, restContext: restContext, clusterId: clusterId})
+}
+
+func (c *onPremTopicApplyClient) listTopics() (map[string]*topicState, error) {
+	topics, resp, err := c.restClient.TopicV3Api.ListKafkaTopics(c.restContext, c.clusterId)
+	if err != nil {
+		return nil, kafkarest.NewError(c.restClient.GetConfig().BasePath, err, resp)
+	}
+
+	states := make(map[string]*topicState, len(topics.Data))
+	for _, topic := range topics.Data {
+		states[topic.TopicName] = &topicState{
+			partitions:        topic.PartitionsCount,
+			replicationFactor: topic.ReplicationFactor,
+			isInternal:        topic.IsInternal,
+		}
+	}
+	return states, nil
+}
+
+func (c *onPremTopicApplyClient) listTopicConfigs(topic string) (map[string]*topicConfig, error) {
+	configsResp, resp, err := c.restClient.ConfigsV3Api.ListKafkaTopicConfigs(c.restContext, c.clusterId, topic)
+	if err != nil {
+		return nil, kafkarest.NewError(c.restClient.GetConfig().BasePath, err, resp)
+	}
+
+	configs := make(map[string]*topicConfig, len(configsResp.Data))
+	for _, config := range configsResp.Data {
+		configs[config.Name] = &topicConfig{isDefault: config.IsDefault}
+		if config.Value != nil {
+			configs[config.Name].value = *config.Value
+		}
+	}
+	return configs, nil
+}
+
+func (c *onPremTopicApplyClient) createTopic(spec *topicSpec) error {
+	topicConfigs := make([]kafkarestv3.CreateTopicRequestDataConfigs, 0, len(spec.Configs))
+	for _, name := range sortedKeys(spec.Configs) {
+		value := spec.Configs[name]
+		topicConfigs = append(topicConfigs, kafkarestv3.CreateTopicRequestDataConfigs{
+			Name:  name,
+			Value: &value,
+		})
+	}
+
+	data := kafkarestv3.CreateTopicRequestData{
+		TopicName:         spec.Name,
+		PartitionsCount:   spec.Partitions,
+		ReplicationFactor: spec.ReplicationFactor,
+		Configs:           topicConfigs,
+	}
+
+	opts := &kafkarestv3.CreateKafkaTopicOpts{CreateTopicRequestData: optional.NewInterface(data)}
+	if _, resp, err := c.restClient.TopicV3Api.CreateKafkaTopic(c.restContext, c.clusterId, opts); err != nil {
+		return kafkarest.NewError(c.restClient.GetConfig().BasePath, err, resp)
+	}
+	return nil
+}
+
+func (c *onPremTopicApplyClient) updateTopicConfigs(topic string, configs map[string]string) error {
+	opts := &kafkarestv3.UpdateKafkaTopicConfigBatchOpts{
+		AlterConfigBatchRequestData: optional.NewInterface(toAlterConfigBatchRequestDataOnPrem(configs)),
+	}
+	if resp, err := c.restClient.ConfigsV3Api.UpdateKafkaTopicConfigBatch(c.restContext, c.clusterId, topic, opts); err != nil {
+		return kafkarest.NewError(c.restClient.GetConfig().BasePath, err, resp)
+	}
+	return nil
+}
+
+func (c *onPremTopicApplyClient) deleteTopic(topic string) error {
+	if resp, err := c.restClient.TopicV3Api.DeleteKafkaTopic(c.restContext, c.clusterId, topic); err != nil {
+		return kafkarest.NewError(c.restClient.GetConfig().BasePath, err, resp)
+	}
+	return nil
+}
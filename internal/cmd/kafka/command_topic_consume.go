@@ -15,6 +15,7 @@ import (
 	"github.com/confluentinc/cli/internal/pkg/errors"
 	"github.com/confluentinc/cli/internal/pkg/examples"
 	"github.com/confluentinc/cli/internal/pkg/log"
+	"github.com/confluentinc/cli/internal/pkg/output"
 	"github.com/confluentinc/cli/internal/pkg/utils"
 )
 
@@ -30,6 +31,26 @@ func newConsumeCommand(prerunner pcmd.PreRunner, clientId string) *cobra.Command
 				Text: `Consume items from the "my_topic" topic and press "Ctrl-C" to exit.`,
 				Code: "confluent kafka topic consume -b my_topic",
 			},
+			examples.Example{
+				Text: `Consume items from the "my_topic" topic as JSON, with one object per message containing its topic, partition, offset, timestamp, headers, key, and value.`,
+				Code: "confluent kafka topic consume -b my_topic --output json",
+			},
+			examples.Example{
+				Text: `Consume all items currently in the "my_topic" topic and exit, failing if no items are consumed for 30 seconds.`,
+				Code: "confluent kafka topic consume -b my_topic --to-end --timeout 30s --fail-on-timeout",
+			},
+			examples.Example{
+				Text: `Consume items produced to the "my_topic" topic in the last 15 minutes.`,
+				Code: "confluent kafka topic consume my_topic --since 15m",
+			},
+			examples.Example{
+				Text: `Consume items from the "my_topic" topic whose "source" header is "web", printing only their offset and the "user.id" field of their JSON value.`,
+				Code: `confluent kafka topic consume -b my_topic --filter "header.source==web" --fields offset,value.user.id`,
+			},
+			examples.Example{
+				Text: `Consume Avro items from the "my_topic" topic with the local schema file "payment.avsc", for applications which do not use Schema Registry.`,
+				Code: "confluent kafka topic consume -b my_topic --value-format avro --schema payment.avsc --no-schema-registry",
+			},
 		),
 	}
 
@@ -43,12 +64,25 @@ func newConsumeCommand(prerunner pcmd.PreRunner, clientId string) *cobra.Command
 	cmd.Flags().String("group", fmt.Sprintf("confluent_cli_consumer_%s", uuid.New()), "Consumer group ID.")
 	cmd.Flags().BoolP("from-beginning", "b", false, "Consume from beginning of the topic.")
 	cmd.Flags().Int64("offset", 0, "The offset from the beginning to consume from.")
+	cmd.Flags().String("from-timestamp", "", `Consume from the first message at or after this timestamp, in RFC 3339 format (for example, "2006-01-02T15:04:05Z") or milliseconds since the epoch.`)
+	cmd.Flags().Duration("since", 0, `Consume from the first message produced within this duration (for example, "15m").`)
 	cmd.Flags().Int32("partition", -1, "The partition to consume from.")
+	cmd.Flags().Int("max-messages", 0, "Exit after consuming this many messages.")
+	cmd.Flags().Int64("until-offset", 0, "Exit once every partition is consumed up to this offset (exclusive).")
+	cmd.Flags().Bool("to-end", false, "Exit once every partition is consumed up to its end offset at the time it is assigned.")
+	cmd.Flags().Duration("timeout", 0, `Exit if no messages are consumed for this duration (for example, "30s").`)
+	cmd.Flags().Bool("fail-on-timeout", false, "Exit with a non-zero exit code when `--timeout` is reached.")
+	pcmd.AddKeyFormatFlag(cmd)
+	cmd.Flags().String("key-schema", "", "The path to the local message key schema file, used with `--no-schema-registry`.")
 	pcmd.AddValueFormatFlag(cmd)
+	cmd.Flags().String("schema", "", "The path to the local schema file, used with `--no-schema-registry`.")
+	cmd.Flags().Bool("no-schema-registry", false, "Decode messages with the local schema files, for messages produced without Schema Registry.")
 	cmd.Flags().Bool("print-key", false, "Print key of the message.")
 	cmd.Flags().Bool("full-header", false, "Print complete content of message headers.")
 	cmd.Flags().String("delimiter", "\t", "The delimiter separating each key and value.")
 	cmd.Flags().Bool("timestamp", false, "Print message timestamp in milliseconds.")
+	cmd.Flags().StringArray("filter", nil, `Only print messages matching this expression, formatted as "<field><operator><value>" with one of the operators "==", "!=", "=~" (regular expression match), or "!~". Fields are "topic", "partition", "offset", "timestamp", "header.<name>", "key", "value", or a path into a JSON key or value, such as "value.user.id". May be repeated, in which case every filter must match.`)
+	cmd.Flags().StringSlice("fields", nil, `A comma-separated list of message fields to print as a JSON object, instead of the whole message. Fields are specified as in "--filter".`)
 	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the consumer client.`)
 	cmd.Flags().String("config-file", "", "The path to the configuration file (in json or avro format) for the consumer client.")
 	cmd.Flags().String("schema-registry-context", "", "The Schema Registry context under which to look up schema ID.")
@@ -60,6 +94,7 @@ func newConsumeCommand(prerunner pcmd.PreRunner, clientId string) *cobra.Command
 	cmd.Flags().String("cluster", "", "Kafka cluster ID.")
 	pcmd.AddContextFlag(cmd, c.CLICommand)
 	cmd.Flags().String("environment", "", "Environment ID.")
+	pcmd.AddOutputFlag(cmd)
 
 	return cmd
 }
@@ -67,6 +102,11 @@ func newConsumeCommand(prerunner pcmd.PreRunner, clientId string) *cobra.Command
 func (c *hasAPIKeyTopicCommand) consume(cmd *cobra.Command, args []string) error {
 	topic := args[0]
 
+	keyFormat, err := cmd.Flags().GetString("key-format")
+	if err != nil {
+		return err
+	}
+
 	valueFormat, err := cmd.Flags().GetString("value-format")
 	if err != nil {
 		return err
@@ -102,6 +142,32 @@ func (c *hasAPIKeyTopicCommand) consume(cmd *cobra.Command, args []string) error
 		return err
 	}
 
+	filterExpressions, err := cmd.Flags().GetStringArray("filter")
+	if err != nil {
+		return err
+	}
+	filters, err := parseMessageFilters(filterExpressions)
+	if err != nil {
+		return err
+	}
+
+	fields, err := cmd.Flags().GetStringSlice("fields")
+	if err != nil {
+		return err
+	}
+	if err := validateMessageFields(fields); err != nil {
+		return err
+	}
+
+	localSchemas, err := getLocalSchemas(cmd)
+	if err != nil {
+		return err
+	}
+
+	if output.GetFormat(cmd) == output.YAML {
+		return errors.NewErrorWithSuggestions(fmt.Sprintf(errors.InvalidFlagValueErrorMsg, output.YAML, output.FlagName), fmt.Sprintf(errors.InvalidFlagValueSuggestions, output.FlagName, "human, json"))
+	}
+
 	if cmd.Flags().Changed("config-file") && cmd.Flags().Changed("config") {
 		return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, "config-file", "config")
 	}
@@ -119,6 +185,7 @@ func (c *hasAPIKeyTopicCommand) consume(cmd *cobra.Command, args []string) error
 	if err != nil {
 		return fmt.Errorf(errors.FailedToCreateConsumerErrorMsg, err)
 	}
+	defer consumer.Close()
 	log.CliLogger.Trace("Create consumer succeeded")
 
 	adminClient, err := ckafka.NewAdminClientFromConsumer(consumer)
@@ -132,8 +199,13 @@ func (c *hasAPIKeyTopicCommand) consume(cmd *cobra.Command, args []string) error
 		return err
 	}
 
-	if cmd.Flags().Changed("from-beginning") && cmd.Flags().Changed("offset") {
-		return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, "from-beginning", "offset")
+	startFlags := []string{"from-beginning", "offset", "from-timestamp", "since"}
+	for i, flag := range startFlags {
+		for _, otherFlag := range startFlags[i+1:] {
+			if cmd.Flags().Changed(flag) && cmd.Flags().Changed(otherFlag) {
+				return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, flag, otherFlag)
+			}
+		}
 	}
 
 	offset, err := getOffsetWithFallback(cmd)
@@ -141,6 +213,16 @@ func (c *hasAPIKeyTopicCommand) consume(cmd *cobra.Command, args []string) error
 		return err
 	}
 
+	startTimestamp, err := getStartTimestamp(cmd)
+	if err != nil {
+		return err
+	}
+
+	bounds, err := getConsumeBounds(cmd)
+	if err != nil {
+		return err
+	}
+
 	partition, err := cmd.Flags().GetInt32("partition")
 	if err != nil {
 		return err
@@ -150,7 +232,7 @@ func (c *hasAPIKeyTopicCommand) consume(cmd *cobra.Command, args []string) error
 		index:   partition,
 	}
 
-	rebalanceCallback := getRebalanceCallback(cmd, offset, partitionFilter)
+	rebalanceCallback := getRebalanceCallback(cmd, offset, startTimestamp, partitionFilter, bounds)
 	err = consumer.Subscribe(topic, rebalanceCallback)
 	if err != nil {
 		return err
@@ -160,7 +242,7 @@ func (c *hasAPIKeyTopicCommand) consume(cmd *cobra.Command, args []string) error
 
 	var srClient *srsdk.APIClient
 	var ctx context.Context
-	if valueFormat != "string" {
+	if (keyFormat != "string" || valueFormat != "string") && len(localSchemas) == 0 {
 		schemaRegistryApiKey, err := cmd.Flags().GetString("schema-registry-api-key")
 		if err != nil {
 			return err
@@ -188,28 +270,36 @@ func (c *hasAPIKeyTopicCommand) consume(cmd *cobra.Command, args []string) error
 		_ = os.RemoveAll(dir)
 	}()
 
-	subject := topicNameStrategy(topic)
+	keySubject := topicNameStrategy(topic, keyMode)
+	subject := topicNameStrategy(topic, valueMode)
 	schemaRegistryContext, err := cmd.Flags().GetString("schema-registry-context")
 	if err != nil {
 		return err
 	}
 	if schemaRegistryContext != "" {
+		keySubject = schemaRegistryContext
 		subject = schemaRegistryContext
 	}
 
 	groupHandler := &GroupHandler{
-		SrClient: srClient,
-		Ctx:      ctx,
-		Format:   valueFormat,
-		Out:      cmd.OutOrStdout(),
-		Subject:  subject,
+		SrClient:   srClient,
+		Ctx:        ctx,
+		KeyFormat:  keyFormat,
+		Format:     valueFormat,
+		Out:        cmd.OutOrStdout(),
+		KeySubject: keySubject,
+		Subject:    subject,
 		Properties: ConsumerProperties{
 			PrintKey:   printKey,
 			FullHeader: fullHeader,
 			Timestamp:  timestamp,
 			Delimiter:  delimiter,
 			SchemaPath: dir,
+			Json:       output.GetFormat(cmd) == output.JSON,
+			Filters:    filters,
+			Fields:     fields,
 		},
+		LocalSchemas: localSchemas,
 	}
-	return runConsumer(cmd, consumer, groupHandler)
+	return runConsumer(cmd, consumer, groupHandler, bounds)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/confluentinc/cli/internal/pkg/errors"
 	"github.com/confluentinc/cli/internal/pkg/examples"
 	"github.com/confluentinc/cli/internal/pkg/log"
+	"github.com/confluentinc/cli/internal/pkg/output"
 	"github.com/confluentinc/cli/internal/pkg/utils"
 )
 
@@ -31,6 +32,9 @@ func (c *authenticatedTopicCommand) newConsumeCommandOnPrem() *cobra.Command {
 			examples.Example{
 				Text: `Consume message from topic "my_topic" with SASL_SSL/OAUTHBEARER protocol enabled (using MDS token).`,
 				Code: `confluent kafka topic consume my_topic --protocol SASL_SSL --sasl-mechanism OAUTHBEARER --bootstrap "localhost:19091" --ca-location my-cert.crt`},
+			examples.Example{
+				Text: `Consume Avro messages from topic "my_topic" with the local schema file "payment.avsc", for applications which do not use Schema Registry.`,
+				Code: `confluent kafka topic consume my_topic --value-format avro --schema payment.avsc --no-schema-registry --bootstrap "localhost:19091" --ca-location my-cert.crt`},
 		),
 	}
 
@@ -40,11 +44,24 @@ func (c *authenticatedTopicCommand) newConsumeCommandOnPrem() *cobra.Command {
 	cmd.Flags().String("group", "", "Consumer group ID.")
 	cmd.Flags().BoolP("from-beginning", "b", false, "Consume from beginning of the topic.")
 	cmd.Flags().Int64("offset", 0, "The offset from the beginning to consume from.")
+	cmd.Flags().String("from-timestamp", "", `Consume from the first message at or after this timestamp, in RFC 3339 format (for example, "2006-01-02T15:04:05Z") or milliseconds since the epoch.`)
+	cmd.Flags().Duration("since", 0, `Consume from the first message produced within this duration (for example, "15m").`)
 	cmd.Flags().Int32("partition", -1, "The partition to consume from.")
+	cmd.Flags().Int("max-messages", 0, "Exit after consuming this many messages.")
+	cmd.Flags().Int64("until-offset", 0, "Exit once every partition is consumed up to this offset (exclusive).")
+	cmd.Flags().Bool("to-end", false, "Exit once every partition is consumed up to its end offset at the time it is assigned.")
+	cmd.Flags().Duration("timeout", 0, `Exit if no messages are consumed for this duration (for example, "30s").`)
+	cmd.Flags().Bool("fail-on-timeout", false, "Exit with a non-zero exit code when `--timeout` is reached.")
+	pcmd.AddKeyFormatFlag(cmd)
+	cmd.Flags().String("key-schema", "", "The path to the local message key schema file, used with `--no-schema-registry`.")
 	pcmd.AddValueFormatFlag(cmd)
+	cmd.Flags().String("schema", "", "The path to the local schema file, used with `--no-schema-registry`.")
+	cmd.Flags().Bool("no-schema-registry", false, "Decode messages with the local schema files, for messages produced without Schema Registry.")
 	cmd.Flags().Bool("print-key", false, "Print key of the message.")
 	cmd.Flags().Bool("full-header", false, "Print complete content of message headers.")
 	cmd.Flags().Bool("timestamp", false, "Print message timestamp in milliseconds.")
+	cmd.Flags().StringArray("filter", nil, `Only print messages matching this expression, formatted as "<field><operator><value>" with one of the operators "==", "!=", "=~" (regular expression match), or "!~". Fields are "topic", "partition", "offset", "timestamp", "header.<name>", "key", "value", or a path into a JSON key or value, such as "value.user.id". May be repeated, in which case every filter must match.`)
+	cmd.Flags().StringSlice("fields", nil, `A comma-separated list of message fields to print as a JSON object, instead of the whole message. Fields are specified as in "--filter".`)
 	cmd.Flags().String("delimiter", "\t", "The delimiter separating each key and value.")
 	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the consumer client.`)
 	cmd.Flags().String("config-file", "", "The path to the configuration file (in json or avro format) for the consumer client.")
@@ -78,6 +95,37 @@ func (c *authenticatedTopicCommand) onPremConsume(cmd *cobra.Command, args []str
 		return err
 	}
 
+	filterExpressions, err := cmd.Flags().GetStringArray("filter")
+	if err != nil {
+		return err
+	}
+	filters, err := parseMessageFilters(filterExpressions)
+	if err != nil {
+		return err
+	}
+
+	fields, err := cmd.Flags().GetStringSlice("fields")
+	if err != nil {
+		return err
+	}
+	if err := validateMessageFields(fields); err != nil {
+		return err
+	}
+
+	localSchemas, err := getLocalSchemas(cmd)
+	if err != nil {
+		return err
+	}
+
+	if output.GetFormat(cmd) == output.YAML {
+		return errors.NewErrorWithSuggestions(fmt.Sprintf(errors.InvalidFlagValueErrorMsg, output.YAML, output.FlagName), fmt.Sprintf(errors.InvalidFlagValueSuggestions, output.FlagName, "human, json"))
+	}
+
+	keyFormat, err := cmd.Flags().GetString("key-format")
+	if err != nil {
+		return err
+	}
+
 	valueFormat, err := cmd.Flags().GetString("value-format")
 	if err != nil {
 		return err
@@ -100,6 +148,7 @@ func (c *authenticatedTopicCommand) onPremConsume(cmd *cobra.Command, args []str
 	if err != nil {
 		return errors.NewErrorWithSuggestions(fmt.Errorf(errors.FailedToCreateConsumerErrorMsg, err).Error(), errors.OnPremConfigGuideSuggestions)
 	}
+	defer consumer.Close()
 	log.CliLogger.Tracef("Create consumer succeeded")
 
 	err = c.refreshOAuthBearerToken(cmd, consumer)
@@ -119,8 +168,13 @@ func (c *authenticatedTopicCommand) onPremConsume(cmd *cobra.Command, args []str
 		return err
 	}
 
-	if cmd.Flags().Changed("from-beginning") && cmd.Flags().Changed("offset") {
-		return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, "from-beginning", "offset")
+	startFlags := []string{"from-beginning", "offset", "from-timestamp", "since"}
+	for i, flag := range startFlags {
+		for _, otherFlag := range startFlags[i+1:] {
+			if cmd.Flags().Changed(flag) && cmd.Flags().Changed(otherFlag) {
+				return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, flag, otherFlag)
+			}
+		}
 	}
 
 	offset, err := getOffsetWithFallback(cmd)
@@ -128,6 +182,16 @@ func (c *authenticatedTopicCommand) onPremConsume(cmd *cobra.Command, args []str
 		return err
 	}
 
+	startTimestamp, err := getStartTimestamp(cmd)
+	if err != nil {
+		return err
+	}
+
+	bounds, err := getConsumeBounds(cmd)
+	if err != nil {
+		return err
+	}
+
 	partition, err := cmd.Flags().GetInt32("partition")
 	if err != nil {
 		return err
@@ -137,7 +201,7 @@ func (c *authenticatedTopicCommand) onPremConsume(cmd *cobra.Command, args []str
 		index:   partition,
 	}
 
-	rebalanceCallback := getRebalanceCallback(cmd, offset, partitionFilter)
+	rebalanceCallback := getRebalanceCallback(cmd, offset, startTimestamp, partitionFilter, bounds)
 	err = consumer.Subscribe(topicName, rebalanceCallback)
 	if err != nil {
 		return err
@@ -147,7 +211,7 @@ func (c *authenticatedTopicCommand) onPremConsume(cmd *cobra.Command, args []str
 
 	var srClient *srsdk.APIClient
 	var ctx context.Context
-	if valueFormat != "string" {
+	if (keyFormat != "string" || valueFormat != "string") && len(localSchemas) == 0 {
 		// Only initialize client and context when schema is specified.
 		if c.State == nil { // require log-in to use oauthbearer token
 			return errors.NewErrorWithSuggestions(errors.NotLoggedInErrorMsg, errors.AuthTokenSuggestions)
@@ -167,17 +231,22 @@ func (c *authenticatedTopicCommand) onPremConsume(cmd *cobra.Command, args []str
 	}()
 
 	groupHandler := &GroupHandler{
-		SrClient: srClient,
-		Ctx:      ctx,
-		Format:   valueFormat,
-		Out:      cmd.OutOrStdout(),
+		SrClient:  srClient,
+		Ctx:       ctx,
+		KeyFormat: keyFormat,
+		Format:    valueFormat,
+		Out:       cmd.OutOrStdout(),
 		Properties: ConsumerProperties{
 			PrintKey:   printKey,
 			FullHeader: fullHeader,
 			Timestamp:  timestamp,
 			Delimiter:  delimiter,
 			SchemaPath: dir,
+			Json:       output.GetFormat(cmd) == output.JSON,
+			Filters:    filters,
+			Fields:     fields,
 		},
+		LocalSchemas: localSchemas,
 	}
-	return runConsumer(cmd, consumer, groupHandler)
+	return runConsumer(cmd, consumer, groupHandler, bounds)
 }
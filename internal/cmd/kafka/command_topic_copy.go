@@ -0,0 +1,309 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	sr "github.com/confluentinc/cli/internal/cmd/schema-registry"
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	v1 "github.com/confluentinc/cli/internal/pkg/config/v1"
+	dynamicconfig "github.com/confluentinc/cli/internal/pkg/dynamic-config"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/log"
+)
+
+const (
+	destinationContextFlagName                 = "destination-context"
+	destinationSchemaRegistryEndpointFlagName  = "destination-schema-registry-endpoint"
+	destinationSchemaRegistryApiKeyFlagName    = "destination-schema-registry-api-key"
+	destinationSchemaRegistryApiSecretFlagName = "destination-schema-registry-api-secret"
+)
+
+func newCopyCommand(prerunner pcmd.PreRunner, clientId string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "copy <source-topic> <destination-topic>",
+		Short: "Copy the messages of a Kafka topic to another topic.",
+		Long: "Copy the messages currently in a Kafka topic to another topic, which may be in a different cluster or context.\n\n" +
+			"The timestamp, headers, key, and value of each message are copied as is. " +
+			"If the destination topic uses a different Schema Registry cluster, set `--destination-schema-registry-endpoint` to register the schemas of message keys and values with it, and replace their schema IDs to match. Schemas that reference other schemas cannot be copied.",
+		Args:        cobra.ExactArgs(2),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Copy the messages of topic "my_topic" to topic "my_topic_copy" in the same cluster.`,
+				Code: "confluent kafka topic copy my_topic my_topic_copy",
+			},
+			examples.Example{
+				Text: `Copy the messages of topic "my_topic" to the same partitions of topic "my_topic" in cluster "lkc-123456" of context "staging".`,
+				Code: "confluent kafka topic copy my_topic my_topic --destination-context staging --destination-cluster lkc-123456 --preserve-partitions",
+			},
+			examples.Example{
+				Text: `Copy the messages of topic "my_topic" to cluster "lkc-123456", which uses a different Schema Registry cluster.`,
+				Code: "confluent kafka topic copy my_topic my_topic --destination-cluster lkc-123456 --destination-schema-registry-endpoint https://psrc-12345.us-west-2.aws.confluent.cloud --destination-schema-registry-api-key 0000000000000000 --destination-schema-registry-api-secret <SECRET>",
+			},
+		),
+	}
+
+	c := &hasAPIKeyTopicCommand{
+		HasAPIKeyCLICommand: pcmd.NewHasAPIKeyCLICommand(cmd, prerunner),
+		prerunner:           prerunner,
+		clientID:            clientId,
+	}
+	cmd.RunE = c.copy
+
+	cmd.Flags().String(destinationContextFlagName, "", "The context of the destination cluster, if different from the current context.")
+	cmd.Flags().String(destinationClusterIdFlagName, "", "The destination Kafka cluster ID, if different from the source cluster.")
+	cmd.Flags().String(destinationApiKeyFlagName, "", "API key for the destination cluster.")
+	cmd.Flags().String(destinationApiSecretFlagName, "", "API key secret for the destination cluster.")
+	cmd.Flags().Bool("preserve-partitions", false, "Produce each message to the same partition of the destination topic.")
+	cmd.Flags().Int("max-messages", 0, "Exit after copying this many messages.")
+	cmd.Flags().Int("max-in-flight", 10000, "The maximum number of messages awaiting a delivery report before producing pauses.")
+	cmd.Flags().String("schema-registry-endpoint", "", "Endpoint for Schema Registry cluster.")
+	cmd.Flags().String("schema-registry-api-key", "", "Schema registry API key.")
+	cmd.Flags().String("schema-registry-api-secret", "", "Schema registry API key secret.")
+	cmd.Flags().String(destinationSchemaRegistryEndpointFlagName, "", "Endpoint for the Schema Registry cluster of the destination topic.")
+	cmd.Flags().String(destinationSchemaRegistryApiKeyFlagName, "", "Schema registry API key for the destination topic.")
+	cmd.Flags().String(destinationSchemaRegistryApiSecretFlagName, "", "Schema registry API key secret for the destination topic.")
+	cmd.Flags().String("api-key", "", "API key.")
+	cmd.Flags().String("api-secret", "", "API key secret.")
+	cmd.Flags().String("cluster", "", "Kafka cluster ID.")
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	cmd.Flags().String("environment", "", "Environment ID.")
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *hasAPIKeyTopicCommand) copy(cmd *cobra.Command, args []string) error {
+	sourceTopic, destinationTopic := args[0], args[1]
+
+	sourceCluster, err := c.Config.Context().GetKafkaClusterForCommand()
+	if err != nil {
+		return err
+	}
+
+	destinationCluster, err := c.getDestinationCluster(cmd)
+	if err != nil {
+		return err
+	}
+
+	preservePartitions, err := cmd.Flags().GetBool("preserve-partitions")
+	if err != nil {
+		return err
+	}
+
+	maxMessages, err := cmd.Flags().GetInt("max-messages")
+	if err != nil {
+		return err
+	}
+	if maxMessages < 0 {
+		return errors.New(errors.InvalidMaxMessagesErrorMsg)
+	}
+
+	maxInFlight, err := cmd.Flags().GetInt("max-in-flight")
+	if err != nil {
+		return err
+	}
+
+	// Schema IDs are only remapped when the destination topic uses a different Schema Registry cluster.
+	var sourceSrClient, destinationSrClient *srsdk.APIClient
+	var sourceCtx, destinationCtx context.Context
+	destinationSrEndpoint, err := cmd.Flags().GetString(destinationSchemaRegistryEndpointFlagName)
+	if err != nil {
+		return err
+	}
+	if destinationSrEndpoint != "" {
+		sourceSrClient, sourceCtx, err = c.getSchemaRegistryClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		destinationSrApiKey, err := cmd.Flags().GetString(destinationSchemaRegistryApiKeyFlagName)
+		if err != nil {
+			return err
+		}
+		destinationSrApiSecret, err := cmd.Flags().GetString(destinationSchemaRegistryApiSecretFlagName)
+		if err != nil {
+			return err
+		}
+		destinationSrClient, destinationCtx, err = sr.GetSchemaRegistryClientWithEndpoint(cmd, c.Version, destinationSrEndpoint, destinationSrApiKey, destinationSrApiSecret)
+		if err != nil {
+			return err
+		}
+	}
+
+	consumer, err := newConsumer(fmt.Sprintf("confluent_cli_copy_%s", uuid.New()), sourceCluster, c.clientID, "", nil)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateConsumerErrorMsg, err)
+	}
+	defer consumer.Close()
+	log.CliLogger.Trace("Create consumer succeeded")
+
+	consumerAdminClient, err := ckafka.NewAdminClientFromConsumer(consumer)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateAdminClientErrorMsg, err)
+	}
+	defer consumerAdminClient.Close()
+
+	if err := c.validateTopic(consumerAdminClient, sourceTopic, sourceCluster); err != nil {
+		return err
+	}
+
+	producer, err := newProducer(destinationCluster, c.clientID, "", nil)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateProducerErrorMsg, err)
+	}
+	defer producer.Close()
+	log.CliLogger.Tracef("Create producer succeeded")
+
+	producerAdminClient, err := ckafka.NewAdminClientFromProducer(producer)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateAdminClientErrorMsg, err)
+	}
+	defer producerAdminClient.Close()
+
+	if err := c.validateTopic(producerAdminClient, destinationTopic, destinationCluster); err != nil {
+		return err
+	}
+
+	// Copy every partition from its beginning up to its end offset at the time it is assigned.
+	bounds := &consumeBounds{maxMessages: maxMessages, untilOffset: -1, toEnd: true, endOffsets: map[int32]int64{}}
+	if err := consumer.Subscribe(sourceTopic, getRebalanceCallback(cmd, ckafka.OffsetBeginning, -1, partitionFilter{}, bounds)); err != nil {
+		return err
+	}
+
+	copier := &topicCopier{topic: destinationTopic, preservePartitions: preservePartitions}
+	if destinationSrClient != nil {
+		copier.remapper = newSchemaRemapper(destinationTopic, destinationSrClient, destinationCtx, func(id int32, mode string) (*archiveSchema, error) {
+			return requestArchiveSchema(id, topicNameStrategy(sourceTopic, mode), sourceSrClient, sourceCtx)
+		})
+	}
+
+	// Consumed messages are handed to the producer as they are, until either side stops.
+	messages := make(chan *ckafka.Message)
+	consumeCtx, cancelConsume := context.WithCancel(context.Background())
+	consumeErr := make(chan error, 1)
+	go func() {
+		defer close(messages)
+		consumeErr <- pollMessages(cmd, consumer, bounds, cmd.ErrOrStderr(), func(msg *ckafka.Message) (bool, error) {
+			select {
+			case messages <- msg:
+				return true, nil
+			case <-consumeCtx.Done():
+				return false, consumeCtx.Err()
+			}
+		})
+	}()
+
+	produceErr := produceMessages(cmd, producer, destinationTopic, maxInFlight, func() (*ckafka.Message, error) {
+		msg, ok := <-messages
+		if !ok {
+			return nil, io.EOF
+		}
+		return copier.copy(msg)
+	})
+
+	// Stop consuming before the consumer is closed, in case producing stopped first.
+	cancelConsume()
+	if err := <-consumeErr; err != nil && err != context.Canceled && produceErr == nil {
+		return err
+	}
+	return produceErr
+}
+
+// topicCopier copies consumed messages to a destination topic. When a schema remapper is set, the schema IDs
+// of message keys and values are remapped to those registered under the subjects of the destination topic.
+type topicCopier struct {
+	topic              string
+	preservePartitions bool
+	remapper           *schemaRemapper
+}
+
+func (c *topicCopier) copy(msg *ckafka.Message) (*ckafka.Message, error) {
+	copied := &ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{Topic: &c.topic, Partition: ckafka.PartitionAny},
+		Timestamp:      msg.Timestamp,
+		Headers:        msg.Headers,
+		Key:            msg.Key,
+		Value:          msg.Value,
+	}
+	if c.preservePartitions {
+		copied.TopicPartition.Partition = msg.TopicPartition.Partition
+	}
+	if c.remapper == nil {
+		return copied, nil
+	}
+
+	var err error
+	if id, ok := getSchemaId(msg.Key); ok {
+		if copied.Key, err = c.remapper.remap(msg.Key, id, keyMode); err != nil {
+			return nil, err
+		}
+	}
+	if id, ok := getSchemaId(msg.Value); ok {
+		if copied.Value, err = c.remapper.remap(msg.Value, id, valueMode); err != nil {
+			return nil, err
+		}
+	}
+	return copied, nil
+}
+
+// getDestinationCluster returns the destination cluster of a copy, which defaults to the current cluster.
+func (c *hasAPIKeyTopicCommand) getDestinationCluster(cmd *cobra.Command) (*v1.KafkaClusterConfig, error) {
+	_, cluster, err := getDestinationContextAndCluster(cmd, c.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := cmd.Flags().GetString(destinationApiKeyFlagName)
+	if err != nil {
+		return nil, err
+	}
+	apiSecret, err := cmd.Flags().GetString(destinationApiSecretFlagName)
+	if err != nil {
+		return nil, err
+	}
+	return withApiKey(cluster, apiKey, apiSecret)
+}
+
+// getDestinationContextAndCluster returns the context and cluster set by the destination flags, which default to the current context and cluster.
+func getDestinationContextAndCluster(cmd *cobra.Command, cfg *dynamicconfig.DynamicConfig) (*dynamicconfig.DynamicContext, *v1.KafkaClusterConfig, error) {
+	ctx := cfg.Context()
+	if contextName, err := cmd.Flags().GetString(destinationContextFlagName); err != nil {
+		return nil, nil, err
+	} else if contextName != "" {
+		ctx, err = cfg.FindContext(contextName)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	clusterId, err := cmd.Flags().GetString(destinationClusterIdFlagName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if clusterId == "" {
+		cluster, err := ctx.GetKafkaClusterForCommand()
+		if err != nil {
+			return nil, nil, err
+		}
+		return ctx, cluster, nil
+	}
+
+	cluster, err := ctx.FindKafkaCluster(clusterId)
+	if err != nil {
+		return nil, nil, errors.CatchKafkaNotFoundError(err, clusterId, nil)
+	}
+	if cluster == nil {
+		return nil, nil, &errors.KafkaClusterNotFoundError{ClusterID: clusterId}
+	}
+	return ctx, cluster, nil
+}
@@ -0,0 +1,82 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/stretchr/testify/require"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	v1 "github.com/confluentinc/cli/internal/pkg/config/v1"
+	dynamicconfig "github.com/confluentinc/cli/internal/pkg/dynamic-config"
+)
+
+func TestGetDestinationCluster(t *testing.T) {
+	req := require.New(t)
+
+	cfg := v1.AuthenticatedCloudConfigMock()
+	prerunner := &pcmd.PreRun{Config: cfg}
+	cmd := newCopyCommand(prerunner, "")
+	c := &hasAPIKeyTopicCommand{HasAPIKeyCLICommand: pcmd.NewHasAPIKeyCLICommand(cmd, prerunner)}
+	c.Config = dynamicconfig.New(cfg, nil, nil)
+
+	cluster, err := c.getDestinationCluster(cmd)
+	req.NoError(err)
+	req.Equal(v1.MockKafkaClusterId(), cluster.ID)
+	req.Equal("costa", cluster.APIKey)
+
+	req.NoError(cmd.Flags().Set(destinationApiKeyFlagName, "my-key"))
+	req.NoError(cmd.Flags().Set(destinationApiSecretFlagName, "my-secret"))
+	cluster, err = c.getDestinationCluster(cmd)
+	req.NoError(err)
+	req.Equal("my-key", cluster.APIKey)
+	req.Equal("my-secret", cluster.APIKeys["my-key"].Secret)
+
+	// The API key is not stored in the configuration.
+	stored, err := c.Config.Context().GetKafkaClusterForCommand()
+	req.NoError(err)
+	req.Equal("costa", stored.APIKey)
+	req.Nil(stored.APIKeys["my-key"])
+
+	req.NoError(cmd.Flags().Set(destinationApiSecretFlagName, ""))
+	_, err = c.getDestinationCluster(cmd)
+	req.Error(err)
+}
+
+func TestTopicCopier_Copy(t *testing.T) {
+	req := require.New(t)
+
+	// A value of 2 MB is copied as is, without passing through a line of input.
+	topic := "my-topic"
+	msg := &ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{Topic: &topic, Partition: 2, Offset: 7},
+		Timestamp:      time.UnixMilli(1672531200000),
+		Headers:        []ckafka.Header{{Key: "app", Value: []byte("cli")}},
+		Key:            []byte("my-key"),
+		Value:          bytes.Repeat([]byte{0xca, 0xfe}, 1024*1024),
+	}
+
+	copier := &topicCopier{topic: "other-topic"}
+	copied, err := copier.copy(msg)
+	req.NoError(err)
+	req.Equal("other-topic", *copied.TopicPartition.Topic)
+	req.Equal(ckafka.PartitionAny, copied.TopicPartition.Partition)
+	req.Equal(msg.Timestamp, copied.Timestamp)
+	req.Equal(msg.Headers, copied.Headers)
+	req.Equal(msg.Key, copied.Key)
+	req.Equal(msg.Value, copied.Value)
+
+	copier = &topicCopier{topic: "other-topic", preservePartitions: true}
+	copier.remapper = newSchemaRemapper("other-topic", &srsdk.APIClient{}, context.Background(), nil)
+	copier.remapper.schemaIds[valueMode][100001] = 5
+	msg.Value = []byte{0x0, 0x0, 0x1, 0x86, 0xa1, 0x2}
+	copied, err = copier.copy(msg)
+	req.NoError(err)
+	req.Equal(int32(2), copied.TopicPartition.Partition)
+	req.Equal([]byte("my-key"), copied.Key)
+	req.Equal([]byte{0x0, 0x0, 0x0, 0x0, 0x5, 0x2}, copied.Value)
+}
@@ -0,0 +1,215 @@
+package kafka
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	pauth "github.com/confluentinc/cli/internal/pkg/auth"
+	"github.com/confluentinc/cli/internal/pkg/ccloudv2"
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/output"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+)
+
+type topicDiffOut struct {
+	Topic       string `human:"Topic" serialized:"topic"`
+	Setting     string `human:"Setting" serialized:"setting"`
+	Source      string `human:"Source" serialized:"source"`
+	Destination string `human:"Destination" serialized:"destination"`
+}
+
+func (c *authenticatedTopicCommand) newDiffCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare the topics of two Kafka clusters.",
+		Long:  "Compare the topics, partition counts, and non-default topic configs of the current Kafka cluster to those of a destination cluster, which may be in a different context.",
+		Args:  cobra.NoArgs,
+		RunE:  c.diff,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Compare the topics of the current cluster to those of cluster "lkc-123456".`,
+				Code: "confluent kafka topic diff --destination-cluster lkc-123456",
+			},
+			examples.Example{
+				Text: `Compare the topics of cluster "lkc-123456" to those of the current cluster of context "staging", as JSON.`,
+				Code: "confluent kafka topic diff --cluster lkc-123456 --destination-context staging --output json",
+			},
+		),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
+	}
+
+	cmd.Flags().String(destinationContextFlagName, "", "The context of the destination cluster, if different from the current context.")
+	cmd.Flags().String(destinationClusterIdFlagName, "", "The destination Kafka cluster ID, if different from the current cluster of the destination context.")
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *authenticatedTopicCommand) diff(cmd *cobra.Command, _ []string) error {
+	sourceCluster, err := c.Context.GetKafkaClusterForCommand()
+	if err != nil {
+		return err
+	}
+
+	if err := c.provisioningClusterCheck(sourceCluster.ID); err != nil {
+		return err
+	}
+
+	sourceKafkaREST, err := c.GetKafkaREST()
+	if sourceKafkaREST == nil {
+		if err != nil {
+			return err
+		}
+		return errors.New(errors.RestProxyNotAvailableMsg)
+	}
+
+	destinationKafkaREST, destinationClusterId, err := c.getDestinationKafkaREST(cmd)
+	if err != nil {
+		return err
+	}
+
+	source := &cloudTopicApplyClient{kafkaREST: sourceKafkaREST, clusterId: sourceCluster.ID}
+	destination := &cloudTopicApplyClient{kafkaREST: destinationKafkaREST, clusterId: destinationClusterId}
+
+	diffs, err := diffTopics(source, destination)
+	if err != nil {
+		return err
+	}
+
+	if len(diffs) == 0 && output.GetFormat(cmd) == output.Human {
+		utils.Printf(cmd, errors.NoTopicDifferencesMsg, sourceCluster.ID, destinationClusterId)
+		return nil
+	}
+
+	list := output.NewList(cmd)
+	for _, diff := range diffs {
+		list.Add(diff)
+	}
+	return list.Print()
+}
+
+// getDestinationKafkaREST returns a Kafka REST client for the destination cluster, authenticated with the destination context.
+func (c *authenticatedTopicCommand) getDestinationKafkaREST(cmd *cobra.Command) (*pcmd.KafkaREST, string, error) {
+	ctx, cluster, err := getDestinationContextAndCluster(cmd, c.Config)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if cluster.RestEndpoint == "" {
+		return nil, "", errors.New(errors.RestProxyNotAvailableMsg)
+	}
+
+	state, err := ctx.AuthenticatedState()
+	if err != nil {
+		return nil, "", err
+	}
+
+	bearerToken, err := pauth.GetBearerToken(state, ctx.Platform.Server, cluster.ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	unsafeTrace, err := cmd.Flags().GetBool("unsafe-trace")
+	if err != nil {
+		return nil, "", err
+	}
+
+	kafkaREST := &pcmd.KafkaREST{CloudClient: ccloudv2.NewKafkaRestClient(cluster.RestEndpoint, c.Version.UserAgent, unsafeTrace, bearerToken)}
+	return kafkaREST, cluster.ID, nil
+}
+
+// diffTopics compares the topics of two clusters, including partition counts and the configs which are not default in either cluster.
+// Internal topics are ignored.
+func diffTopics(source, destination topicLister) ([]*topicDiffOut, error) {
+	sourceTopics, err := source.listTopics()
+	if err != nil {
+		return nil, err
+	}
+
+	destinationTopics, err := destination.listTopics()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for name, state := range sourceTopics {
+		if !state.isInternal {
+			names = append(names, name)
+		}
+	}
+	for name, state := range destinationTopics {
+		if _, ok := sourceTopics[name]; !ok && !state.isInternal {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var diffs []*topicDiffOut
+	for _, name := range names {
+		sourceTopic, destinationTopic := sourceTopics[name], destinationTopics[name]
+		if sourceTopic == nil || destinationTopic == nil {
+			diffs = append(diffs, &topicDiffOut{
+				Topic:       name,
+				Setting:     "exists",
+				Source:      strconv.FormatBool(sourceTopic != nil),
+				Destination: strconv.FormatBool(destinationTopic != nil),
+			})
+			continue
+		}
+
+		if sourceTopic.partitions != destinationTopic.partitions {
+			diffs = append(diffs, &topicDiffOut{
+				Topic:       name,
+				Setting:     "partitions",
+				Source:      strconv.Itoa(int(sourceTopic.partitions)),
+				Destination: strconv.Itoa(int(destinationTopic.partitions)),
+			})
+		}
+
+		sourceConfigs, err := source.listTopicConfigs(name)
+		if err != nil {
+			return nil, err
+		}
+
+		destinationConfigs, err := destination.listTopicConfigs(name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, config := range getNonDefaultConfigNames(sourceConfigs, destinationConfigs) {
+			sourceValue, destinationValue := getConfigValue(sourceConfigs, config), getConfigValue(destinationConfigs, config)
+			if sourceValue != destinationValue {
+				diffs = append(diffs, &topicDiffOut{
+					Topic:       name,
+					Setting:     config,
+					Source:      sourceValue,
+					Destination: destinationValue,
+				})
+			}
+		}
+	}
+
+	return diffs, nil
+}
+
+func getNonDefaultConfigNames(configs ...map[string]*topicConfig) []string {
+	isNonDefault := map[string]bool{}
+	var names []string
+	for _, m := range configs {
+		for name, config := range m {
+			if !config.isDefault && !isNonDefault[name] {
+				isNonDefault[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,50 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffTopics(t *testing.T) {
+	req := require.New(t)
+
+	source := &fakeTopicApplyClient{
+		topics: map[string]*topicState{
+			"orders":             {partitions: 6},
+			"payments":           {partitions: 1},
+			"refunds":            {partitions: 1},
+			"__consumer_offsets": {partitions: 50, isInternal: true},
+		},
+		configs: map[string]map[string]*topicConfig{
+			"orders":   {"cleanup.policy": {value: "delete", isDefault: true}, "retention.ms": {value: "259200000"}},
+			"payments": {"cleanup.policy": {value: "compact"}},
+		},
+	}
+
+	destination := &fakeTopicApplyClient{
+		topics: map[string]*topicState{
+			"orders":   {partitions: 6},
+			"payments": {partitions: 3},
+			"legacy":   {partitions: 1},
+		},
+		configs: map[string]map[string]*topicConfig{
+			"orders":   {"cleanup.policy": {value: "compact"}, "retention.ms": {value: "604800000", isDefault: true}},
+			"payments": {"cleanup.policy": {value: "compact"}},
+		},
+	}
+
+	diffs, err := diffTopics(source, destination)
+	req.NoError(err)
+	req.Equal([]*topicDiffOut{
+		{Topic: "legacy", Setting: "exists", Source: "false", Destination: "true"},
+		{Topic: "orders", Setting: "cleanup.policy", Source: "delete", Destination: "compact"},
+		{Topic: "orders", Setting: "retention.ms", Source: "259200000", Destination: "604800000"},
+		{Topic: "payments", Setting: "partitions", Source: "1", Destination: "3"},
+		{Topic: "refunds", Setting: "exists", Source: "true", Destination: "false"},
+	}, diffs)
+
+	diffs, err = diffTopics(source, source)
+	req.NoError(err)
+	req.Empty(diffs)
+}
@@ -0,0 +1,158 @@
+package kafka
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/log"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+)
+
+func newExportCommand(prerunner pcmd.PreRunner, clientId string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <topic>",
+		Short: "Export the messages of a Kafka topic to a file.",
+		Long: "Export the messages currently in a Kafka topic to a file, which can be imported to a topic with the `import` command.\n\n" +
+			"The file is a JSON lines archive which preserves the partition, offset, timestamp, headers, key, and value of each message. " +
+			"Keys, values, and header values are stored as base64-encoded bytes.",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Export the messages of topic "my_topic" to "my_topic.jsonl".`,
+				Code: "confluent kafka topic export my_topic --file my_topic.jsonl",
+			},
+			examples.Example{
+				Text: `Export the first 1000 messages of topic "my_topic", along with the schemas of their keys and values.`,
+				Code: "confluent kafka topic export my_topic --file my_topic.jsonl --max-messages 1000 --include-schemas",
+			},
+		),
+	}
+
+	c := &hasAPIKeyTopicCommand{
+		HasAPIKeyCLICommand: pcmd.NewHasAPIKeyCLICommand(cmd, prerunner),
+		prerunner:           prerunner,
+		clientID:            clientId,
+	}
+	cmd.RunE = c.export
+
+	cmd.Flags().String("file", "", "The path to the file to export messages to.")
+	cmd.Flags().Int("max-messages", 0, "Exit after exporting this many messages.")
+	cmd.Flags().Bool("include-schemas", false, "Include the schemas of message keys and values, which must not reference other schemas, in the file, so that their schema IDs can be remapped on import.")
+	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the consumer client.`)
+	cmd.Flags().String("config-file", "", "The path to the configuration file (in json or avro format) for the consumer client.")
+	cmd.Flags().String("schema-registry-endpoint", "", "Endpoint for Schema Registry cluster.")
+	cmd.Flags().String("schema-registry-api-key", "", "Schema registry API key.")
+	cmd.Flags().String("schema-registry-api-secret", "", "Schema registry API key secret.")
+	cmd.Flags().String("api-key", "", "API key.")
+	cmd.Flags().String("api-secret", "", "API key secret.")
+	cmd.Flags().String("cluster", "", "Kafka cluster ID.")
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	cmd.Flags().String("environment", "", "Environment ID.")
+
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func (c *hasAPIKeyTopicCommand) export(cmd *cobra.Command, args []string) error {
+	topic := args[0]
+
+	cluster, err := c.Config.Context().GetKafkaClusterForCommand()
+	if err != nil {
+		return err
+	}
+
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	maxMessages, err := cmd.Flags().GetInt("max-messages")
+	if err != nil {
+		return err
+	}
+	if maxMessages < 0 {
+		return errors.New(errors.InvalidMaxMessagesErrorMsg)
+	}
+
+	includeSchemas, err := cmd.Flags().GetBool("include-schemas")
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("config-file") && cmd.Flags().Changed("config") {
+		return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, "config-file", "config")
+	}
+
+	configFile, err := cmd.Flags().GetString("config-file")
+	if err != nil {
+		return err
+	}
+	config, err := cmd.Flags().GetStringSlice("config")
+	if err != nil {
+		return err
+	}
+
+	var srClient *srsdk.APIClient
+	var ctx context.Context
+	if includeSchemas {
+		srClient, ctx, err = c.getSchemaRegistryClient(cmd)
+		if err != nil {
+			return err
+		}
+	}
+
+	consumer, err := newConsumer(fmt.Sprintf("confluent_cli_export_%s", uuid.New()), cluster, c.clientID, configFile, config)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateConsumerErrorMsg, err)
+	}
+	defer consumer.Close()
+	log.CliLogger.Trace("Create consumer succeeded")
+
+	adminClient, err := ckafka.NewAdminClientFromConsumer(consumer)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateAdminClientErrorMsg, err)
+	}
+	defer adminClient.Close()
+
+	if err := c.validateTopic(adminClient, topic, cluster); err != nil {
+		return err
+	}
+
+	// Export every partition from its beginning up to its end offset at the time it is assigned.
+	bounds := &consumeBounds{maxMessages: maxMessages, untilOffset: -1, toEnd: true, endOffsets: map[int32]int64{}}
+	if err := consumer.Subscribe(topic, getRebalanceCallback(cmd, ckafka.OffsetBeginning, -1, partitionFilter{}, bounds)); err != nil {
+		return err
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	writer := bufio.NewWriter(f)
+	exporter := newTopicExporter(writer, topic, srClient, ctx)
+	if err := pollMessages(cmd, consumer, bounds, cmd.OutOrStdout(), func(msg *ckafka.Message) (bool, error) {
+		return true, exporter.export(msg)
+	}); err != nil {
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	utils.Printf(cmd, errors.ExportedTopicMsg, exporter.exported, topic, file)
+	return nil
+}
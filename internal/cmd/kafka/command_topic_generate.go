@@ -0,0 +1,198 @@
+package kafka
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"time"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/spf13/cobra"
+
+	sr "github.com/confluentinc/cli/internal/cmd/schema-registry"
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/log"
+	"github.com/confluentinc/cli/internal/pkg/serdes"
+)
+
+func newGenerateCommand(prerunner pcmd.PreRunner, clientId string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate <topic>",
+		Short: "Produce random messages to a Kafka topic.",
+		Long: "Produce random messages which are valid for an Avro, JSON, or Protobuf schema registered in Schema Registry.\n\n" +
+			"Generated values respect enums, logical types and formats, and nullable unions. " +
+			"Messages are serialized with the schema ID of the schema, and have no key.",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Produce 100 messages to topic "orders" with the latest schema of subject "orders-value".`,
+				Code: "confluent kafka topic generate orders --schema-subject orders-value --count 100",
+			},
+			examples.Example{
+				Text: `Produce 5 messages per second to topic "orders" until interrupted.`,
+				Code: "confluent kafka topic generate orders --schema-subject orders-value --count 0 --rate 5",
+			},
+		),
+	}
+
+	c := &hasAPIKeyTopicCommand{
+		HasAPIKeyCLICommand: pcmd.NewHasAPIKeyCLICommand(cmd, prerunner),
+		prerunner:           prerunner,
+		clientID:            clientId,
+	}
+	cmd.RunE = c.generate
+
+	cmd.Flags().String("schema-subject", "", "The subject of the schema to generate messages for.")
+	cmd.Flags().String("schema-version", "latest", `The version of the schema, or "latest".`)
+	cmd.Flags().Int("count", 10, "The number of messages to produce, or 0 to produce until interrupted.")
+	cmd.Flags().Float64("rate", 0, "The maximum number of messages to produce per second, or 0 for no limit.")
+	cmd.Flags().Int("max-in-flight", 10000, "The maximum number of messages awaiting a delivery report before producing pauses.")
+	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the producer client.`)
+	cmd.Flags().String("config-file", "", "The path to the configuration file (in json or avro format) for the producer client.")
+	cmd.Flags().String("schema-registry-endpoint", "", "Endpoint for Schema Registry cluster.")
+	cmd.Flags().String("schema-registry-api-key", "", "Schema registry API key.")
+	cmd.Flags().String("schema-registry-api-secret", "", "Schema registry API key secret.")
+	cmd.Flags().String("api-key", "", "API key.")
+	cmd.Flags().String("api-secret", "", "API key secret.")
+	cmd.Flags().String("cluster", "", "Kafka cluster ID.")
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	cmd.Flags().String("environment", "", "Environment ID.")
+	pcmd.AddOutputFlag(cmd)
+
+	_ = cmd.MarkFlagRequired("schema-subject")
+
+	return cmd
+}
+
+func (c *hasAPIKeyTopicCommand) generate(cmd *cobra.Command, args []string) error {
+	topic := args[0]
+
+	cluster, err := c.Config.Context().GetKafkaClusterForCommand()
+	if err != nil {
+		return err
+	}
+
+	subject, err := cmd.Flags().GetString("schema-subject")
+	if err != nil {
+		return err
+	}
+
+	version, err := cmd.Flags().GetString("schema-version")
+	if err != nil {
+		return err
+	}
+
+	count, err := cmd.Flags().GetInt("count")
+	if err != nil {
+		return err
+	}
+	if count < 0 {
+		return errors.New(errors.InvalidCountErrorMsg)
+	}
+
+	rate, err := cmd.Flags().GetFloat64("rate")
+	if err != nil {
+		return err
+	}
+	if rate < 0 {
+		return errors.New(errors.InvalidRateErrorMsg)
+	}
+
+	maxInFlight, err := cmd.Flags().GetInt("max-in-flight")
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("config-file") && cmd.Flags().Changed("config") {
+		return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, "config-file", "config")
+	}
+
+	configFile, err := cmd.Flags().GetString("config-file")
+	if err != nil {
+		return err
+	}
+	config, err := cmd.Flags().GetStringSlice("config")
+	if err != nil {
+		return err
+	}
+
+	srClient, ctx, err := c.getSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	schema, httpResp, err := srClient.DefaultApi.GetSchemaByVersion(ctx, subject, version, nil)
+	if err != nil {
+		return errors.CatchSchemaNotFoundError(err, httpResp)
+	}
+
+	generator, err := sr.NewSchemaGenerator(srClient, ctx, schema, rand.New(rand.NewSource(time.Now().UnixNano())))
+	if err != nil {
+		return err
+	}
+
+	format, err := serdes.FormatTranslation(schema.SchemaType)
+	if err != nil {
+		return err
+	}
+
+	serializationProvider, err := serdes.GetSerializationProvider(format)
+	if err != nil {
+		return err
+	}
+
+	dir, err := sr.CreateTempDir()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	schemaString := srsdk.SchemaString{SchemaType: schema.SchemaType, Schema: schema.Schema, References: schema.References}
+	schemaPath, referencePathMap, err := sr.SetSchemaPathRef(schemaString, dir, subject, schema.Id, srClient, ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := serializationProvider.LoadSchema(schemaPath, referencePathMap); err != nil {
+		return errors.NewWrapErrorWithSuggestions(err, "failed to load schema", errors.FailedToLoadSchemaSuggestions)
+	}
+
+	metaInfo := sr.GetMetaInfoFromSchemaId(schema.Id)
+
+	producer, err := newProducer(cluster, c.clientID, configFile, config)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateProducerErrorMsg, err)
+	}
+	defer producer.Close()
+	log.CliLogger.Tracef("Create producer succeeded")
+
+	adminClient, err := ckafka.NewAdminClientFromProducer(producer)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateAdminClientErrorMsg, err)
+	}
+	defer adminClient.Close()
+
+	if err := c.validateTopic(adminClient, topic, cluster); err != nil {
+		return err
+	}
+
+	getMessage := func(data string) (*ckafka.Message, error) {
+		_, value, err := getMsgKeyAndValue(nil, metaInfo, data, "", false, nil, serializationProvider)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ckafka.Message{
+			TopicPartition: ckafka.TopicPartition{Topic: &topic, Partition: ckafka.PartitionAny},
+			Value:          value,
+		}, nil
+	}
+
+	return runProducer(cmd, producer, topic, maxInFlight, newGeneratedMessageReader(generator.Generate, count, rate), getMessage)
+}
@@ -0,0 +1,142 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/log"
+)
+
+func newImportCommand(prerunner pcmd.PreRunner, clientId string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <topic>",
+		Short: "Import messages to a Kafka topic from a file.",
+		Long: "Import messages to a Kafka topic from a file created with the `export` command.\n\n" +
+			"The timestamp, headers, key, and value of each message are preserved. " +
+			"Messages are produced with the same schema IDs as they were exported with, unless `--remap-schemas` is set.",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Import the messages in "my_topic.jsonl" to topic "my_topic", preserving their partitions.`,
+				Code: "confluent kafka topic import my_topic --file my_topic.jsonl --preserve-partitions",
+			},
+			examples.Example{
+				Text: `Import the messages in "my_topic.jsonl" to topic "my_topic", registering the schemas of their keys and values with a different Schema Registry.`,
+				Code: "confluent kafka topic import my_topic --file my_topic.jsonl --remap-schemas --schema-registry-endpoint https://psrc-12345.us-west-2.aws.confluent.cloud",
+			},
+		),
+	}
+
+	c := &hasAPIKeyTopicCommand{
+		HasAPIKeyCLICommand: pcmd.NewHasAPIKeyCLICommand(cmd, prerunner),
+		prerunner:           prerunner,
+		clientID:            clientId,
+	}
+	cmd.RunE = c.importMessages
+
+	cmd.Flags().String("file", "", "The path to the file to import messages from.")
+	cmd.Flags().Bool("preserve-partitions", false, "Produce each message to the partition it was exported from.")
+	cmd.Flags().Bool("remap-schemas", false, "Register the schemas in the file under the subjects of the topic, and replace the schema IDs of message keys and values with the registered schema IDs.")
+	cmd.Flags().Int("max-in-flight", 10000, "The maximum number of messages awaiting a delivery report before producing pauses.")
+	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the producer client.`)
+	cmd.Flags().String("config-file", "", "The path to the configuration file (in json or avro format) for the producer client.")
+	cmd.Flags().String("schema-registry-endpoint", "", "Endpoint for Schema Registry cluster.")
+	cmd.Flags().String("schema-registry-api-key", "", "Schema registry API key.")
+	cmd.Flags().String("schema-registry-api-secret", "", "Schema registry API key secret.")
+	cmd.Flags().String("api-key", "", "API key.")
+	cmd.Flags().String("api-secret", "", "API key secret.")
+	cmd.Flags().String("cluster", "", "Kafka cluster ID.")
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	cmd.Flags().String("environment", "", "Environment ID.")
+	pcmd.AddOutputFlag(cmd)
+
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func (c *hasAPIKeyTopicCommand) importMessages(cmd *cobra.Command, args []string) error {
+	topic := args[0]
+
+	cluster, err := c.Config.Context().GetKafkaClusterForCommand()
+	if err != nil {
+		return err
+	}
+
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	preservePartitions, err := cmd.Flags().GetBool("preserve-partitions")
+	if err != nil {
+		return err
+	}
+
+	remapSchemas, err := cmd.Flags().GetBool("remap-schemas")
+	if err != nil {
+		return err
+	}
+
+	maxInFlight, err := cmd.Flags().GetInt("max-in-flight")
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("config-file") && cmd.Flags().Changed("config") {
+		return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, "config-file", "config")
+	}
+
+	configFile, err := cmd.Flags().GetString("config-file")
+	if err != nil {
+		return err
+	}
+	config, err := cmd.Flags().GetStringSlice("config")
+	if err != nil {
+		return err
+	}
+
+	var srClient *srsdk.APIClient
+	var ctx context.Context
+	if remapSchemas {
+		srClient, ctx, err = c.getSchemaRegistryClient(cmd)
+		if err != nil {
+			return err
+		}
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	producer, err := newProducer(cluster, c.clientID, configFile, config)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateProducerErrorMsg, err)
+	}
+	defer producer.Close()
+	log.CliLogger.Tracef("Create producer succeeded")
+
+	adminClient, err := ckafka.NewAdminClientFromProducer(producer)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateAdminClientErrorMsg, err)
+	}
+	defer adminClient.Close()
+
+	if err := c.validateTopic(adminClient, topic, cluster); err != nil {
+		return err
+	}
+
+	importer := newTopicImporter(f, topic, preservePartitions, srClient, ctx)
+	return produceMessages(cmd, producer, topic, maxInFlight, importer.next)
+}
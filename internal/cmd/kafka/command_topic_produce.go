@@ -1,12 +1,12 @@
 package kafka
 
 import (
-	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
+	"time"
 
 	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
 	srsdk "github.com/confluentinc/schema-registry-sdk-go"
@@ -15,18 +15,40 @@ import (
 	sr "github.com/confluentinc/cli/internal/cmd/schema-registry"
 	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
 	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
 	"github.com/confluentinc/cli/internal/pkg/log"
 	"github.com/confluentinc/cli/internal/pkg/serdes"
 	"github.com/confluentinc/cli/internal/pkg/utils"
 )
 
+const (
+	textInputFormat  = "text"
+	jsonlInputFormat = "jsonl"
+)
+
+var inputFormats = []string{textInputFormat, jsonlInputFormat}
+
 func newProduceCommand(prerunner pcmd.PreRunner, clientId string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:         "produce <topic>",
 		Short:       "Produce messages to a Kafka topic.",
-		Long:        "Produce messages to a Kafka topic.\n\nWhen using this command, you cannot modify the message header, and the message header will not be printed out.",
+		Long:        "Produce messages to a Kafka topic.\n\nMessage headers can be attached to every message with the `--headers` flag, or read from each line of input with the `--parse-headers` flag, in which case each line is formatted as `<headers><headers-delimiter><message>`.",
 		Args:        cobra.ExactArgs(1),
 		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Produce messages with the headers "app" and "env" to topic "my_topic".`,
+				Code: "confluent kafka topic produce my_topic --headers app:cli,env:dev",
+			},
+			examples.Example{
+				Text: `Produce messages to topic "my_topic", reading the headers and key of each message from the input (for example, "app:cli,env:dev|my-key:my-value").`,
+				Code: "confluent kafka topic produce my_topic --parse-headers --parse-key",
+			},
+			examples.Example{
+				Text: `Produce Avro messages to topic "my_topic" with the local schema file "payment.avsc", for applications which do not use Schema Registry.`,
+				Code: "confluent kafka topic produce my_topic --value-format avro --schema payment.avsc --no-schema-registry",
+			},
+		),
 	}
 
 	c := &hasAPIKeyTopicCommand{
@@ -36,12 +58,22 @@ func newProduceCommand(prerunner pcmd.PreRunner, clientId string) *cobra.Command
 	}
 	cmd.RunE = c.produce
 
+	pcmd.AddKeyFormatFlag(cmd)
+	cmd.Flags().String("key-schema", "", "The path to the message key schema file.")
+	cmd.Flags().Int32("key-schema-id", 0, "The ID of the message key schema.")
+	cmd.Flags().String("key-references", "", "The path to the message key schema references file.")
 	cmd.Flags().String("schema", "", "The path to the schema file.")
 	cmd.Flags().Int32("schema-id", 0, "The ID of the schema.")
 	pcmd.AddValueFormatFlag(cmd)
 	cmd.Flags().String("references", "", "The path to the references file.")
+	cmd.Flags().Bool("no-schema-registry", false, "Encode messages with the local schema files without registering them, and without the schema ID and other Schema Registry framing.")
 	cmd.Flags().Bool("parse-key", false, "Parse key from the message.")
 	cmd.Flags().String("delimiter", ":", "The delimiter separating each key and value.")
+	cmd.Flags().StringSlice("headers", nil, `A comma-separated list of headers formatted as "key:value", attached to every message.`)
+	cmd.Flags().Bool("parse-headers", false, "Parse headers from the message.")
+	cmd.Flags().String("headers-delimiter", "|", "The delimiter separating the headers from the key and value.")
+	addInputFormatFlag(cmd)
+	cmd.Flags().Int("max-in-flight", 10000, "The maximum number of messages awaiting a delivery report before producing pauses.")
 	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the producer client.`)
 	cmd.Flags().String("config-file", "", "The path to the configuration file (in json or avro format) for the producer client.")
 	cmd.Flags().String("schema-registry-endpoint", "", "Endpoint for Schema Registry cluster.")
@@ -64,11 +96,28 @@ func (c *hasAPIKeyTopicCommand) produce(cmd *cobra.Command, args []string) error
 		return err
 	}
 
+	if cmd.Flags().Changed("key-schema") && cmd.Flags().Changed("key-schema-id") {
+		return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, "key-schema", "key-schema-id")
+	}
+
 	if cmd.Flags().Changed("schema") && cmd.Flags().Changed("schema-id") {
 		return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, "schema", "schema-id")
 	}
 
-	serializationProvider, metaInfo, err := c.initSchemaAndGetInfo(cmd, topic)
+	if err := validateInputFormat(cmd); err != nil {
+		return err
+	}
+
+	if _, err := validateNoSchemaRegistry(cmd); err != nil {
+		return err
+	}
+
+	keySerializer, keyMetaInfo, err := c.initSchemaAndGetInfo(cmd, topic, keyMode)
+	if err != nil {
+		return err
+	}
+
+	valueSerializer, valueMetaInfo, err := c.initSchemaAndGetInfo(cmd, topic, valueMode)
 	if err != nil {
 		return err
 	}
@@ -104,72 +153,16 @@ func (c *hasAPIKeyTopicCommand) produce(cmd *cobra.Command, args []string) error
 		return err
 	}
 
-	utils.ErrPrintln(cmd, errors.StartingProducerMsg)
-
-	// Line reader for producer input.
-	scanner := bufio.NewScanner(os.Stdin)
-	// CCloud Kafka messageMaxBytes:
-	// https://github.com/confluentinc/cc-spec-kafka/blob/9f0af828d20e9339aeab6991f32d8355eb3f0776/plugins/kafka/kafka.go#L43.
-	const maxScanTokenSize = 1024*1024*2 + 12
-	scanner.Buffer(nil, maxScanTokenSize)
-	input := make(chan string, 1)
-	// Avoid blocking in for loop so ^C or ^D can exit immediately.
-	var scanErr error
-	scan := func() {
-		hasNext := scanner.Scan()
-		if !hasNext {
-			// Actual error.
-			if scanner.Err() != nil {
-				scanErr = scanner.Err()
-			}
-			// Otherwise just EOF.
-			close(input)
-		} else {
-			input <- scanner.Text()
-		}
+	maxInFlight, err := cmd.Flags().GetInt("max-in-flight")
+	if err != nil {
+		return err
 	}
 
-	// Trap SIGINT to trigger a shutdown.
-	signals := make(chan os.Signal, 1)
-	signal.Notify(signals, os.Interrupt)
-	go func() {
-		<-signals
-		close(input)
-	}()
-	// Prime reader
-	go scan()
-
-	deliveryChan := make(chan ckafka.Event)
-	for data := range input {
-		if len(data) == 0 {
-			go scan()
-			continue
-		}
-
-		msg, err := getProduceMessage(cmd, metaInfo, topic, data, serializationProvider)
-		if err != nil {
-			return err
-		}
-		err = producer.Produce(msg, deliveryChan)
-		if err != nil {
-			isProduceToCompactedTopicError, err := errors.CatchProduceToCompactedTopicError(err, topic)
-			if isProduceToCompactedTopicError {
-				scanErr = err
-				close(input)
-				break
-			}
-			utils.ErrPrintf(cmd, errors.FailedToProduceErrorMsg, msg.TopicPartition.Offset, err)
-		}
+	utils.ErrPrintln(cmd, errors.StartingProducerMsg)
 
-		e := <-deliveryChan                // read a ckafka event from the channel
-		m := e.(*ckafka.Message)           // extract the message from the event
-		if m.TopicPartition.Error != nil { // catch all other errors
-			utils.ErrPrintf(cmd, errors.FailedToProduceErrorMsg, m.TopicPartition.Offset, m.TopicPartition.Error)
-		}
-		go scan()
-	}
-	close(deliveryChan)
-	return scanErr
+	return runProducer(cmd, producer, topic, maxInFlight, os.Stdin, func(data string) (*ckafka.Message, error) {
+		return getProduceMessage(cmd, keyMetaInfo, valueMetaInfo, topic, data, keySerializer, valueSerializer)
+	})
 }
 
 func (c *hasAPIKeyTopicCommand) getSchemaRegistryClient(cmd *cobra.Command) (*srsdk.APIClient, context.Context, error) {
@@ -213,7 +206,49 @@ func (c *hasAPIKeyTopicCommand) registerSchema(cmd *cobra.Command, schemaCfg *sr
 	return metaInfo, referencePathMap, nil
 }
 
-func getProduceMessage(cmd *cobra.Command, metaInfo []byte, topicName, data string, serializationProvider serdes.SerializationProvider) (*ckafka.Message, error) {
+func addInputFormatFlag(cmd *cobra.Command) {
+	cmd.Flags().String("input-format", textInputFormat, fmt.Sprintf(`Format of each line of input as %s. With "%s", each line is a JSON object with optional "headers", "key", "value", and "timestamp" fields, as printed by "confluent kafka topic consume --output json". Keys, values, and header values which are not valid UTF-8 are base64-encoded, as indicated by the "key_encoding", "value_encoding", and header "encoding" fields.`, utils.ArrayToCommaDelimitedString(inputFormats), jsonlInputFormat))
+	pcmd.RegisterFlagCompletionFunc(cmd, "input-format", func(_ *cobra.Command, _ []string) []string { return inputFormats })
+}
+
+func validateInputFormat(cmd *cobra.Command) error {
+	inputFormat, err := cmd.Flags().GetString("input-format")
+	if err != nil {
+		return err
+	}
+
+	if !utils.Contains(inputFormats, inputFormat) {
+		return errors.NewErrorWithSuggestions(fmt.Sprintf(errors.InvalidFlagValueErrorMsg, inputFormat, "input-format"), fmt.Sprintf(errors.InvalidFlagValueSuggestions, "input-format", utils.ArrayToCommaDelimitedString(inputFormats)))
+	}
+
+	if inputFormat == jsonlInputFormat {
+		for _, flag := range []string{"parse-key", "parse-headers"} {
+			if cmd.Flags().Changed(flag) {
+				return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, "input-format", flag)
+			}
+		}
+	}
+
+	return nil
+}
+
+func getProduceMessage(cmd *cobra.Command, keyMetaInfo, valueMetaInfo []byte, topicName, data string, keySerializer, valueSerializer serdes.SerializationProvider) (*ckafka.Message, error) {
+	inputFormat, err := cmd.Flags().GetString("input-format")
+	if err != nil {
+		return nil, err
+	}
+	headerStrings, err := cmd.Flags().GetStringSlice("headers")
+	if err != nil {
+		return nil, err
+	}
+	if inputFormat == jsonlInputFormat {
+		headers, err := getHeaders(headerStrings)
+		if err != nil {
+			return nil, err
+		}
+		return getProduceMessageFromJson(keyMetaInfo, valueMetaInfo, topicName, data, keySerializer, valueSerializer, headers)
+	}
+
 	parseKey, err := cmd.Flags().GetBool("parse-key")
 	if err != nil {
 		return nil, err
@@ -222,42 +257,187 @@ func getProduceMessage(cmd *cobra.Command, metaInfo []byte, topicName, data stri
 	if err != nil {
 		return nil, err
 	}
-	key, value, err := getMsgKeyAndValue(metaInfo, data, delimiter, parseKey, serializationProvider)
+	parseHeaders, err := cmd.Flags().GetBool("parse-headers")
+	if err != nil {
+		return nil, err
+	}
+	if parseHeaders {
+		headersDelimiter, err := cmd.Flags().GetString("headers-delimiter")
+		if err != nil {
+			return nil, err
+		}
+		var messageHeaders []string
+		messageHeaders, data, err = getMsgHeaders(data, headersDelimiter)
+		if err != nil {
+			return nil, err
+		}
+		headerStrings = append(headerStrings, messageHeaders...)
+	}
+	headers, err := getHeaders(headerStrings)
+	if err != nil {
+		return nil, err
+	}
+
+	key, value, err := getMsgKeyAndValue(keyMetaInfo, valueMetaInfo, data, delimiter, parseKey, keySerializer, valueSerializer)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ckafka.Message{
 		TopicPartition: ckafka.TopicPartition{Topic: &topicName, Partition: ckafka.PartitionAny},
-		Key:            []byte(key),
-		Value:          []byte(value),
+		Key:            key,
+		Value:          value,
+		Headers:        headers,
 	}, nil
 }
 
-func getMsgKeyAndValue(metaInfo []byte, data, delimiter string, parseKey bool, serializationProvider serdes.SerializationProvider) (string, string, error) {
-	var key, valueString string
+// getMsgHeaders splits the comma-separated list of headers at the start of a line of input from the rest of the message.
+func getMsgHeaders(data, headersDelimiter string) ([]string, string, error) {
+	record := strings.SplitN(data, headersDelimiter, 2)
+	if len(record) != 2 {
+		return nil, "", errors.New(errors.MissingHeadersErrorMsg)
+	}
+
+	var headerStrings []string
+	if headers := strings.TrimSpace(record[0]); headers != "" {
+		headerStrings = strings.Split(headers, ",")
+	}
+	return headerStrings, record[1], nil
+}
+
+func getHeaders(headerStrings []string) ([]ckafka.Header, error) {
+	if len(headerStrings) == 0 {
+		return nil, nil
+	}
+
+	headers := make([]ckafka.Header, len(headerStrings))
+	for i, header := range headerStrings {
+		pair := strings.SplitN(header, ":", 2)
+		key := strings.TrimSpace(pair[0])
+		if len(pair) != 2 || key == "" {
+			return nil, errors.Errorf(errors.InvalidHeaderErrorMsg, header)
+		}
+		headers[i] = ckafka.Header{Key: key, Value: []byte(strings.TrimSpace(pair[1]))}
+	}
+	return headers, nil
+}
+
+func getMsgKeyAndValue(keyMetaInfo, valueMetaInfo []byte, data, delimiter string, parseKey bool, keySerializer, valueSerializer serdes.SerializationProvider) ([]byte, []byte, error) {
+	var keyString, valueString string
 	if parseKey {
 		record := strings.SplitN(data, delimiter, 2)
 		valueString = strings.TrimSpace(record[len(record)-1])
 
 		if len(record) == 2 {
-			key = strings.TrimSpace(record[0])
+			keyString = strings.TrimSpace(record[0])
 		} else {
-			return "", "", errors.New(errors.MissingKeyErrorMsg)
+			return nil, nil, errors.New(errors.MissingKeyErrorMsg)
 		}
 	} else {
 		valueString = strings.TrimSpace(data)
 	}
-	encodedMessage, err := serdes.Serialize(serializationProvider, valueString)
+
+	var key []byte
+	if parseKey {
+		var err error
+		key, err = serializeMessageData(keyMetaInfo, keyString, keySerializer)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	value, err := serializeMessageData(valueMetaInfo, valueString, valueSerializer)
 	if err != nil {
-		return "", "", err
+		return nil, nil, err
 	}
-	encoded := append(metaInfo, encodedMessage...)
-	value := string(encoded)
+
 	return key, value, nil
 }
 
-func (c *hasAPIKeyTopicCommand) initSchemaAndGetInfo(cmd *cobra.Command, topic string) (serdes.SerializationProvider, []byte, error) {
+func getProduceMessageFromJson(keyMetaInfo, valueMetaInfo []byte, topicName, data string, keySerializer, valueSerializer serdes.SerializationProvider, headers []ckafka.Header) (*ckafka.Message, error) {
+	envelope := new(messageEnvelope)
+	if err := json.Unmarshal([]byte(data), envelope); err != nil {
+		return nil, fmt.Errorf(errors.InvalidJsonMessageErrorMsg, err)
+	}
+
+	msg := &ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{Topic: &topicName, Partition: ckafka.PartitionAny},
+		Headers:        headers,
+	}
+
+	for _, header := range envelope.Headers {
+		var value []byte
+		if header.Value != nil {
+			decoded, err := decodeString(*header.Value, header.Encoding)
+			if err != nil {
+				return nil, err
+			}
+			value = []byte(decoded)
+		}
+		msg.Headers = append(msg.Headers, ckafka.Header{Key: header.Key, Value: value})
+	}
+
+	if envelope.Timestamp != 0 {
+		msg.Timestamp = time.UnixMilli(envelope.Timestamp)
+	}
+
+	if keyString, ok, err := fromRawMessage(envelope.Key, envelope.KeyEncoding); err != nil {
+		return nil, err
+	} else if ok {
+		msg.Key, err = serializeMessageData(keyMetaInfo, keyString, keySerializer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if valueString, ok, err := fromRawMessage(envelope.Value, envelope.ValueEncoding); err != nil {
+		return nil, err
+	} else if ok {
+		msg.Value, err = serializeMessageData(valueMetaInfo, valueString, valueSerializer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return msg, nil
+}
+
+// fromRawMessage returns the textual form of the key or value of a message envelope, decoded with its encoding, and whether it is set.
+func fromRawMessage(raw json.RawMessage, encoding string) (string, bool, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", false, nil
+	}
+
+	if raw[0] == '"' {
+		var str string
+		if err := json.Unmarshal(raw, &str); err != nil {
+			return "", false, err
+		}
+		decoded, err := decodeString(str, encoding)
+		return decoded, err == nil, err
+	}
+
+	if encoding != "" {
+		return "", false, errors.Errorf(errors.UnknownMessageEncodingErrorMsg, encoding, base64Encoding)
+	}
+	return string(raw), true, nil
+}
+
+func serializeMessageData(metaInfo []byte, data string, serializer serdes.SerializationProvider) ([]byte, error) {
+	// Without the meta info of a registered schema, messages are not in the Schema Registry wire format.
+	serialize := serdes.Serialize
+	if len(metaInfo) == 0 {
+		serialize = serdes.SerializePlain
+	}
+
+	encoded, err := serialize(serializer, data)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, metaInfo...), encoded...), nil
+}
+
+func (c *hasAPIKeyTopicCommand) initSchemaAndGetInfo(cmd *cobra.Command, topic, mode string) (serdes.SerializationProvider, []byte, error) {
 	dir, err := sr.CreateTempDir()
 	if err != nil {
 		return nil, nil, err
@@ -266,23 +446,24 @@ func (c *hasAPIKeyTopicCommand) initSchemaAndGetInfo(cmd *cobra.Command, topic s
 		_ = os.RemoveAll(dir)
 	}()
 
-	subject := topicNameStrategy(topic)
+	flags := schemaFlagsByMode[mode]
+	subject := topicNameStrategy(topic, mode)
 
-	schemaId, err := cmd.Flags().GetInt32("schema-id")
+	schemaId, err := cmd.Flags().GetInt32(flags.schemaId)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	schemaPath, err := cmd.Flags().GetString("schema")
+	schemaPath, err := cmd.Flags().GetString(flags.schema)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var valueFormat string
+	var format string
 	referencePathMap := map[string]string{}
 	metaInfo := []byte{}
 
-	if cmd.Flags().Changed("schema-id") {
+	if cmd.Flags().Changed(flags.schemaId) {
 		// request schema information from schemaID
 		srClient, ctx, err := c.getSchemaRegistryClient(cmd)
 		if err != nil {
@@ -294,7 +475,7 @@ func (c *hasAPIKeyTopicCommand) initSchemaAndGetInfo(cmd *cobra.Command, topic s
 			return nil, nil, err
 		}
 
-		valueFormat, err = serdes.FormatTranslation(schemaString.SchemaType)
+		format, err = serdes.FormatTranslation(schemaString.SchemaType)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -306,27 +487,36 @@ func (c *hasAPIKeyTopicCommand) initSchemaAndGetInfo(cmd *cobra.Command, topic s
 
 		metaInfo = sr.GetMetaInfoFromSchemaId(schemaId)
 	} else {
-		valueFormat, err = cmd.Flags().GetString("value-format")
+		format, err = cmd.Flags().GetString(flags.format)
 		if err != nil {
 			return nil, nil, err
 		}
 	}
 
-	serializationProvider, err := serdes.GetSerializationProvider(valueFormat)
+	serializationProvider, err := serdes.GetSerializationProvider(format)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	noSchemaRegistry, err := cmd.Flags().GetBool("no-schema-registry")
 	if err != nil {
 		return nil, nil, err
 	}
 
-	if schemaPath != "" && !cmd.Flags().Changed("schema-id") {
+	if schemaPath != "" && !cmd.Flags().Changed(flags.schemaId) && !noSchemaRegistry {
 		// read schema info from local file and register schema
 		schemaCfg := &sr.RegisterSchemaConfigs{
 			SchemaDir:   dir,
 			SchemaPath:  &schemaPath,
 			Subject:     subject,
-			ValueFormat: valueFormat,
+			ValueFormat: format,
 			SchemaType:  serializationProvider.GetSchemaName(),
 		}
-		refs, err := sr.ReadSchemaRefs(cmd)
+		references, err := cmd.Flags().GetString(flags.references)
+		if err != nil {
+			return nil, nil, err
+		}
+		refs, err := sr.ReadSchemaReferences(references)
 		if err != nil {
 			return nil, nil, err
 		}
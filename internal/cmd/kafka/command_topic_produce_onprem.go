@@ -1,10 +1,8 @@
 package kafka
 
 import (
-	"bufio"
 	"fmt"
 	"os"
-	"os/signal"
 
 	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
 	"github.com/spf13/cobra"
@@ -24,7 +22,7 @@ func (c *authenticatedTopicCommand) newProduceCommandOnPrem() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE:  c.onPremProduce,
 		Short: "Produce messages to a Kafka topic.",
-		Long:  "Produce messages to a Kafka topic. Configuration and command guide: https://docs.confluent.io/confluent-cli/current/cp-produce-consume.html.\n\nWhen using this command, you cannot modify the message header, and the message header will not be printed out.",
+		Long:  "Produce messages to a Kafka topic. Configuration and command guide: https://docs.confluent.io/confluent-cli/current/cp-produce-consume.html.\n\nMessage headers can be attached to every message with the `--headers` flag, or read from each line of input with the `--parse-headers` flag, in which case each line is formatted as `<headers><headers-delimiter><message>`.",
 		Example: examples.BuildExampleString(
 			examples.Example{
 				Text: `Produce message to topic "my_topic" with SASL_SSL/PLAIN protocol (providing username and password).`,
@@ -34,17 +32,30 @@ func (c *authenticatedTopicCommand) newProduceCommandOnPrem() *cobra.Command {
 				Text: `Produce message to topic "my_topic" with SSL protocol, and SSL verification enabled.`,
 				Code: `confluent kafka topic produce my_topic --protocol SSL --bootstrap "localhost:18091" --ca-location my-cert.crt`,
 			},
+			examples.Example{
+				Text: `Produce Avro messages to topic "my_topic" with the local schema file "payment.avsc", for applications which do not use Schema Registry.`,
+				Code: `confluent kafka topic produce my_topic --value-format avro --schema payment.avsc --no-schema-registry --bootstrap "localhost:18091" --ca-location my-cert.crt`,
+			},
 		),
 	}
 
 	cmd.Flags().AddFlagSet(pcmd.OnPremAuthenticationSet())
 	pcmd.AddProtocolFlag(cmd)
 	pcmd.AddMechanismFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddKeyFormatFlag(cmd)
+	cmd.Flags().String("key-schema", "", "The path to the local message key schema file.")
+	cmd.Flags().String("key-references", "", "The path to the message key schema references file.")
 	cmd.Flags().String("schema", "", "The path to the local schema file.")
 	pcmd.AddValueFormatFlag(cmd)
 	cmd.Flags().String("references", "", "The path to the references file.")
+	cmd.Flags().Bool("no-schema-registry", false, "Encode messages with the local schema files without registering them, and without the schema ID and other Schema Registry framing.")
 	cmd.Flags().Bool("parse-key", false, "Parse key from the message.")
 	cmd.Flags().String("delimiter", ":", "The delimiter separating each key and value.")
+	cmd.Flags().StringSlice("headers", nil, `A comma-separated list of headers formatted as "key:value", attached to every message.`)
+	cmd.Flags().Bool("parse-headers", false, "Parse headers from the message.")
+	cmd.Flags().String("headers-delimiter", "|", "The delimiter separating the headers from the key and value.")
+	addInputFormatFlag(cmd)
+	cmd.Flags().Int("max-in-flight", 10000, "The maximum number of messages awaiting a delivery report before producing pauses.")
 	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the producer client.`)
 	cmd.Flags().String("config-file", "", "The path to the configuration file (in json or avro format) for the producer client.")
 	cmd.Flags().String("schema-registry-endpoint", "", "The URL of the Schema Registry cluster.")
@@ -57,6 +68,14 @@ func (c *authenticatedTopicCommand) newProduceCommandOnPrem() *cobra.Command {
 }
 
 func (c *authenticatedTopicCommand) onPremProduce(cmd *cobra.Command, args []string) error {
+	if err := validateInputFormat(cmd); err != nil {
+		return err
+	}
+
+	if _, err := validateNoSchemaRegistry(cmd); err != nil {
+		return err
+	}
+
 	if cmd.Flags().Changed("config-file") && cmd.Flags().Changed("config") {
 		return errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, "config-file", "config")
 	}
@@ -94,22 +113,56 @@ func (c *authenticatedTopicCommand) onPremProduce(cmd *cobra.Command, args []str
 		return err
 	}
 
-	valueFormat, subject, serializationProvider, err := prepareSerializer(cmd, topicName)
+	keySerializer, keyMetaInfo, err := c.initSchemaAndGetInfo(cmd, topicName, keyMode)
 	if err != nil {
 		return err
 	}
 
-	schema, err := cmd.Flags().GetString("schema")
+	valueSerializer, valueMetaInfo, err := c.initSchemaAndGetInfo(cmd, topicName, valueMode)
 	if err != nil {
 		return err
 	}
-	refs, err := sr.ReadSchemaRefs(cmd)
+
+	maxInFlight, err := cmd.Flags().GetInt("max-in-flight")
 	if err != nil {
 		return err
 	}
+
+	utils.ErrPrintln(cmd, errors.StartingProducerMsg)
+
+	return runProducer(cmd, producer, topicName, maxInFlight, os.Stdin, func(data string) (*ckafka.Message, error) {
+		return getProduceMessage(cmd, keyMetaInfo, valueMetaInfo, topicName, data, keySerializer, valueSerializer)
+	})
+}
+
+func (c *authenticatedTopicCommand) initSchemaAndGetInfo(cmd *cobra.Command, topicName, mode string) (serdes.SerializationProvider, []byte, error) {
+	flags := schemaFlagsByMode[mode]
+
+	format, err := cmd.Flags().GetString(flags.format)
+	if err != nil {
+		return nil, nil, err
+	}
+	subject := topicNameStrategy(topicName, mode)
+	serializationProvider, err := serdes.GetSerializationProvider(format)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schema, err := cmd.Flags().GetString(flags.schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	references, err := cmd.Flags().GetString(flags.references)
+	if err != nil {
+		return nil, nil, err
+	}
+	refs, err := sr.ReadSchemaReferences(references)
+	if err != nil {
+		return nil, nil, err
+	}
 	dir, err := sr.CreateTempDir()
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	defer func() {
 		_ = os.RemoveAll(dir)
@@ -120,96 +173,29 @@ func (c *authenticatedTopicCommand) onPremProduce(cmd *cobra.Command, args []str
 		Subject:     subject,
 		SchemaDir:   dir,
 		SchemaType:  serializationProvider.GetSchemaName(),
-		ValueFormat: valueFormat,
+		ValueFormat: format,
 		SchemaPath:  &schema,
 		Refs:        refs,
 	}
-	metaInfo, referencePathMap, err := c.registerSchema(cmd, schemaCfg)
+	noSchemaRegistry, err := cmd.Flags().GetBool("no-schema-registry")
 	if err != nil {
-		return err
-	}
-	err = serializationProvider.LoadSchema(schema, referencePathMap)
-	if err != nil {
-		return err
-	}
-
-	utils.ErrPrintln(cmd, errors.StartingProducerMsg)
-
-	// Line reader for producer input.
-	scanner := bufio.NewScanner(os.Stdin)
-	// On-prem Kafka messageMaxBytes: using the same value of cloud. TODO: allow larger sizes if customers request
-	// https://github.com/confluentinc/cc-spec-kafka/blob/9f0af828d20e9339aeab6991f32d8355eb3f0776/plugins/kafka/kafka.go#L43.
-	const maxScanTokenSize = 1024*1024*2 + 12
-	scanner.Buffer(nil, maxScanTokenSize)
-	input := make(chan string, 1)
-	// Avoid blocking in for loop so ^C or ^D can exit immediately.
-	var scanErr error
-	scan := func() {
-		hasNext := scanner.Scan()
-		if !hasNext {
-			// Actual error.
-			if scanner.Err() != nil {
-				scanErr = scanner.Err()
-			}
-			// Otherwise just EOF.
-			close(input)
-		} else {
-			input <- scanner.Text()
-		}
+		return nil, nil, err
 	}
 
-	signals := make(chan os.Signal, 1) // Trap SIGINT to trigger a shutdown.
-	signal.Notify(signals, os.Interrupt)
-	go func() {
-		<-signals
-		close(input)
-	}()
-	go scan() // Prime reader
-
-	deliveryChan := make(chan ckafka.Event)
-	for data := range input {
-		if len(data) == 0 {
-			go scan()
-			continue
-		}
-
-		msg, err := getProduceMessage(cmd, metaInfo, topicName, data, serializationProvider)
-		if err != nil {
-			return err
-		}
-		err = producer.Produce(msg, deliveryChan)
+	metaInfo := []byte{}
+	referencePathMap := map[string]string{}
+	if !noSchemaRegistry {
+		metaInfo, referencePathMap, err = c.registerSchema(cmd, schemaCfg)
 		if err != nil {
-			utils.ErrPrintf(cmd, errors.FailedToProduceErrorMsg, msg.TopicPartition.Offset, err)
-		}
-
-		e := <-deliveryChan                // read a ckafka event from the channel
-		m := e.(*ckafka.Message)           // extract the message from the event
-		if m.TopicPartition.Error != nil { // catch all other errors
-			isProduceToCompactedTopicError, err := errors.CatchProduceToCompactedTopicError(err, topicName)
-			if isProduceToCompactedTopicError {
-				scanErr = err
-				close(input)
-				break
-			}
-			utils.ErrPrintf(cmd, errors.FailedToProduceErrorMsg, m.TopicPartition.Offset, m.TopicPartition.Error)
+			return nil, nil, err
 		}
-		go scan()
 	}
-	close(deliveryChan)
-	return scanErr
-}
-
-func prepareSerializer(cmd *cobra.Command, topicName string) (string, string, serdes.SerializationProvider, error) {
-	valueFormat, err := cmd.Flags().GetString("value-format")
-	if err != nil {
-		return "", "", nil, err
-	}
-	subject := topicNameStrategy(topicName)
-	serializationProvider, err := serdes.GetSerializationProvider(valueFormat)
+	err = serializationProvider.LoadSchema(schema, referencePathMap)
 	if err != nil {
-		return "", "", nil, err
+		return nil, nil, err
 	}
-	return valueFormat, subject, serializationProvider, nil
+
+	return serializationProvider, metaInfo, nil
 }
 
 func (c *authenticatedTopicCommand) registerSchema(cmd *cobra.Command, schemaCfg *sr.RegisterSchemaConfigs) ([]byte, map[string]string, error) {
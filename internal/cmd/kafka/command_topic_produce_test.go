@@ -0,0 +1,217 @@
+package kafka
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	sr "github.com/confluentinc/cli/internal/cmd/schema-registry"
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/serdes"
+)
+
+func TestGetMsgKeyAndValue(t *testing.T) {
+	req := require.New(t)
+
+	schemaPath := filepath.Join(t.TempDir(), "key-schema.avsc")
+	req.NoError(os.WriteFile(schemaPath, []byte(`{"type":"record","name":"Key","fields":[{"name":"id","type":"int"}]}`), 0644))
+
+	keySerializer, err := serdes.GetSerializationProvider(serdes.AVROSCHEMANAME)
+	req.NoError(err)
+	req.NoError(keySerializer.LoadSchema(schemaPath, map[string]string{}))
+
+	valueSerializer, err := serdes.GetSerializationProvider(serdes.RAWSCHEMANAME)
+	req.NoError(err)
+
+	keyMetaInfo := sr.GetMetaInfoFromSchemaId(100001)
+	key, value, err := getMsgKeyAndValue(keyMetaInfo, []byte{}, `{"id":1}|my-value`, "|", true, keySerializer, valueSerializer)
+	req.NoError(err)
+	req.Equal(append([]byte{0x0, 0x0, 0x1, 0x86, 0xa1}, 0x2), key)
+	req.Equal([]byte("my-value"), value)
+}
+
+func TestGetMsgKeyAndValue_NoKey(t *testing.T) {
+	req := require.New(t)
+
+	serializer, err := serdes.GetSerializationProvider(serdes.RAWSCHEMANAME)
+	req.NoError(err)
+
+	key, value, err := getMsgKeyAndValue([]byte{}, []byte{}, "my-value", ":", false, serializer, serializer)
+	req.NoError(err)
+	req.Nil(key)
+	req.Equal([]byte("my-value"), value)
+
+	_, _, err = getMsgKeyAndValue([]byte{}, []byte{}, "my-value", ":", true, serializer, serializer)
+	req.EqualError(err, "missing key in message")
+}
+
+func TestGetMsgHeaders(t *testing.T) {
+	req := require.New(t)
+
+	headerStrings, data, err := getMsgHeaders("app:cli,env:dev|my-key:my-value", "|")
+	req.NoError(err)
+	req.Equal([]string{"app:cli", "env:dev"}, headerStrings)
+	req.Equal("my-key:my-value", data)
+
+	headerStrings, data, err = getMsgHeaders("|my-value", "|")
+	req.NoError(err)
+	req.Empty(headerStrings)
+	req.Equal("my-value", data)
+
+	_, _, err = getMsgHeaders("my-value", "|")
+	req.EqualError(err, "missing headers in message")
+}
+
+func TestGetHeaders(t *testing.T) {
+	req := require.New(t)
+
+	headers, err := getHeaders([]string{"app:cli", "url:http://localhost", "empty:"})
+	req.NoError(err)
+	req.Equal([]ckafka.Header{
+		{Key: "app", Value: []byte("cli")},
+		{Key: "url", Value: []byte("http://localhost")},
+		{Key: "empty", Value: []byte{}},
+	}, headers)
+
+	headers, err = getHeaders(nil)
+	req.NoError(err)
+	req.Nil(headers)
+
+	_, err = getHeaders([]string{"app"})
+	req.EqualError(err, `invalid header "app": headers must be formatted as "key:value"`)
+}
+
+func TestGetProduceMessageFromJson(t *testing.T) {
+	req := require.New(t)
+
+	serializer, err := serdes.GetSerializationProvider(serdes.RAWSCHEMANAME)
+	req.NoError(err)
+
+	data := `{"topic":"other-topic","partition":2,"offset":10,"timestamp":1672531200000,"headers":[{"key":"app","value":"cli"},{"key":"empty","value":null}],"key":"my:key","value":{"id":1}}`
+	msg, err := getProduceMessageFromJson([]byte{}, []byte{}, "my-topic", data, serializer, serializer, []ckafka.Header{{Key: "env", Value: []byte("dev")}})
+	req.NoError(err)
+	req.Equal("my-topic", *msg.TopicPartition.Topic)
+	req.Equal(ckafka.PartitionAny, msg.TopicPartition.Partition)
+	req.Equal(int64(1672531200000), msg.Timestamp.UnixMilli())
+	req.Equal([]ckafka.Header{
+		{Key: "env", Value: []byte("dev")},
+		{Key: "app", Value: []byte("cli")},
+		{Key: "empty"},
+	}, msg.Headers)
+	req.Equal([]byte("my:key"), msg.Key)
+	req.Equal([]byte(`{"id":1}`), msg.Value)
+
+	msg, err = getProduceMessageFromJson([]byte{}, []byte{}, "my-topic", `{"value":null}`, serializer, serializer, nil)
+	req.NoError(err)
+	req.Nil(msg.Key)
+	req.Nil(msg.Value)
+
+	data = `{"headers":[{"key":"trace","value":"/w==","encoding":"base64"}],"key":"AP8=","key_encoding":"base64","value":"gAE=","value_encoding":"base64"}`
+	msg, err = getProduceMessageFromJson([]byte{}, []byte{}, "my-topic", data, serializer, serializer, nil)
+	req.NoError(err)
+	req.Equal([]ckafka.Header{{Key: "trace", Value: []byte{0xff}}}, msg.Headers)
+	req.Equal([]byte{0x00, 0xff}, msg.Key)
+	req.Equal([]byte{0x80, 0x01}, msg.Value)
+
+	_, err = getProduceMessageFromJson([]byte{}, []byte{}, "my-topic", `{"value":"AP8=","value_encoding":"hex"}`, serializer, serializer, nil)
+	req.EqualError(err, `unknown encoding "hex" of message data: only "base64" is supported`)
+
+	_, err = getProduceMessageFromJson([]byte{}, []byte{}, "my-topic", "my-value", serializer, serializer, nil)
+	req.Error(err)
+}
+
+func TestConsumeMessageAsJson(t *testing.T) {
+	req := require.New(t)
+
+	topic := "my-topic"
+	out := new(bytes.Buffer)
+	h := &GroupHandler{
+		KeyFormat: serdes.RAWSCHEMANAME,
+		Format:    serdes.RAWSCHEMANAME,
+		Out:       out,
+	}
+	msg := &ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{Topic: &topic, Partition: 1, Offset: 5},
+		Timestamp:      time.UnixMilli(1672531200000),
+		Headers:        []ckafka.Header{{Key: "app", Value: []byte("cli")}},
+		Key:            []byte("my:key"),
+		Value:          []byte(`{"id":1}`),
+	}
+	req.NoError(consumeMessageAsJson(msg, h))
+	req.Equal(`{"topic":"my-topic","partition":1,"offset":5,"timestamp":1672531200000,"headers":[{"key":"app","value":"cli"}],"key":"my:key","value":"{\"id\":1}"}`+"\n", out.String())
+
+	out.Reset()
+	msg.Headers = []ckafka.Header{{Key: "trace", Value: []byte{0xff}}}
+	msg.Key = []byte{0x00, 0xff}
+	msg.Value = []byte{0x80, 0x01}
+	req.NoError(consumeMessageAsJson(msg, h))
+	req.Equal(`{"topic":"my-topic","partition":1,"offset":5,"timestamp":1672531200000,"headers":[{"key":"trace","value":"/w==","encoding":"base64"}],"key":"AP8=","key_encoding":"base64","value":"gAE=","value_encoding":"base64"}`+"\n", out.String())
+}
+
+func TestProduceAndConsumeWithLocalSchema(t *testing.T) {
+	req := require.New(t)
+
+	schemaPath := filepath.Join(t.TempDir(), "person.proto")
+	req.NoError(os.WriteFile(schemaPath, []byte(`syntax = "proto3"; message Person { string name = 1; }`), 0644))
+
+	serializer, err := serdes.GetSerializationProvider(serdes.PROTOBUFSCHEMANAME)
+	req.NoError(err)
+	req.NoError(serializer.LoadSchema(schemaPath, map[string]string{}))
+
+	value, err := serializeMessageData([]byte{}, `{"name":"abc"}`, serializer)
+	req.NoError(err)
+	req.Equal([]byte{10, 3, 97, 98, 99}, value)
+
+	out := new(bytes.Buffer)
+	h := &GroupHandler{
+		KeyFormat:    serdes.RAWSCHEMANAME,
+		Format:       serdes.PROTOBUFSCHEMANAME,
+		Out:          out,
+		LocalSchemas: map[string]string{valueMode: schemaPath},
+	}
+	req.NoError(printMessage(&ckafka.Message{Value: value}, h))
+	req.Equal(`{"name":"abc"}`+"\n", out.String())
+}
+
+func TestValidateNoSchemaRegistry(t *testing.T) {
+	req := require.New(t)
+
+	newCommand := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		pcmd.AddKeyFormatFlag(cmd)
+		cmd.Flags().String("key-schema", "", "")
+		pcmd.AddValueFormatFlag(cmd)
+		cmd.Flags().String("schema", "", "")
+		cmd.Flags().Int32("schema-id", 0, "")
+		cmd.Flags().Bool("no-schema-registry", false, "")
+		req.NoError(cmd.ParseFlags(args))
+		return cmd
+	}
+
+	noSchemaRegistry, err := validateNoSchemaRegistry(newCommand("--value-format", "avro", "--schema", "schema.avsc"))
+	req.NoError(err)
+	req.False(noSchemaRegistry)
+
+	noSchemaRegistry, err = validateNoSchemaRegistry(newCommand("--value-format", "avro", "--schema", "schema.avsc", "--no-schema-registry"))
+	req.NoError(err)
+	req.True(noSchemaRegistry)
+
+	_, err = validateNoSchemaRegistry(newCommand("--key-format", "avro", "--schema", "schema.avsc", "--no-schema-registry"))
+	req.EqualError(err, "must pass `--key-schema` to use format \"avro\" with `--no-schema-registry`")
+
+	_, err = validateNoSchemaRegistry(newCommand("--schema-id", "100001", "--no-schema-registry"))
+	req.EqualError(err, "cannot use `--no-schema-registry` and `--schema-id` flags at the same time")
+
+	localSchemas, err := getLocalSchemas(newCommand("--value-format", "avro", "--schema", "schema.avsc", "--no-schema-registry"))
+	req.NoError(err)
+	req.Equal(map[string]string{valueMode: "schema.avsc"}, localSchemas)
+
+	_, err = getLocalSchemas(newCommand("--value-format", "avro", "--schema", "schema.avsc"))
+	req.EqualError(err, "`--schema` can only be used with `--no-schema-registry`")
+}
@@ -37,11 +37,16 @@ func (c *authenticatedTopicCommand) newUpdateCommand() *cobra.Command {
 				Text: `Modify the "my_topic" topic to have a retention period of 3 days (259200000 milliseconds).`,
 				Code: `confluent kafka topic update my_topic --config "retention.ms=259200000"`,
 			},
+			examples.Example{
+				Text: `Increase the number of partitions of the "my_topic" topic to 12.`,
+				Code: "confluent kafka topic update my_topic --partitions 12",
+			},
 		),
 		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
 	}
 
 	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides with form "key=value".`)
+	cmd.Flags().Uint32("partitions", 0, "Increase the number of topic partitions to this number. Messages with the same key may be produced to a different partition than before.")
 	cmd.Flags().Bool("dry-run", false, "Run the command without committing changes to Kafka.")
 	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddContextFlag(cmd, c.CLICommand)
@@ -83,6 +88,23 @@ func (c *authenticatedTopicCommand) update(cmd *cobra.Command, args []string) er
 		return err
 	}
 
+	partitions, err := cmd.Flags().GetUint32("partitions")
+	if err != nil {
+		return err
+	}
+
+	updatePartitions := cmd.Flags().Changed("partitions")
+	if updatePartitions {
+		numPartitions, err := c.getNumPartitions(topicName)
+		if err != nil {
+			return err
+		}
+		if int(partitions) <= numPartitions {
+			return errors.Errorf(errors.DecreasePartitionCountErrorMsg, topicName, numPartitions)
+		}
+		utils.ErrPrintln(cmd, errors.IncreasePartitionCountWarning)
+	}
+
 	// num.partitions is read-only but requires special handling
 	_, hasNumPartitionsChanged := configMap[numPartitionsKey]
 	if hasNumPartitionsChanged {
@@ -93,15 +115,27 @@ func (c *authenticatedTopicCommand) update(cmd *cobra.Command, args []string) er
 	data := toAlterConfigBatchRequestData(configMap)
 	data.ValidateOnly = &dryRun
 
-	httpResp, err := kafkaREST.CloudClient.UpdateKafkaTopicConfigBatch(kafkaClusterConfig.ID, topicName, data)
-	if err != nil {
-		restErr, parseErr := kafkarest.ParseOpenAPIErrorCloud(err)
-		if parseErr == nil {
-			if restErr.Code == ccloudv2.UnknownTopicOrPartitionErrorCode {
+	if len(data.Data) > 0 || !updatePartitions {
+		httpResp, err := kafkaREST.CloudClient.UpdateKafkaTopicConfigBatch(kafkaClusterConfig.ID, topicName, data)
+		if err != nil {
+			restErr, parseErr := kafkarest.ParseOpenAPIErrorCloud(err)
+			if parseErr == nil {
+				if restErr.Code == ccloudv2.UnknownTopicOrPartitionErrorCode {
+					return fmt.Errorf(errors.UnknownTopicErrorMsg, topicName)
+				}
+			}
+			return kafkarest.NewError(kafkaREST.CloudClient.GetUrl(), err, httpResp)
+		}
+	}
+
+	// The partition count API does not support validation, so it is only called after the configs are validated.
+	if updatePartitions && !dryRun {
+		if httpResp, err := kafkaREST.CloudClient.UpdateKafkaTopicPartitionCount(kafkaClusterConfig.ID, topicName, int32(partitions)); err != nil {
+			if restErr, ok := err.(*kafkarest.V3Error); ok && restErr.Code == ccloudv2.UnknownTopicOrPartitionErrorCode {
 				return fmt.Errorf(errors.UnknownTopicErrorMsg, topicName)
 			}
+			return kafkarest.NewError(kafkaREST.CloudClient.GetUrl(), err, httpResp)
 		}
-		return kafkarest.NewError(kafkaREST.CloudClient.GetUrl(), err, httpResp)
 	}
 
 	if dryRun {
@@ -126,7 +160,11 @@ func (c *authenticatedTopicCommand) update(cmd *cobra.Command, args []string) er
 		configsValues[conf.Name] = conf.GetValue()
 	}
 
-	if hasNumPartitionsChanged {
+	if updatePartitions {
+		configsValues[numPartitionsKey] = strconv.Itoa(int(partitions))
+		partitionsKafkaRestConfig := kafkarestv3.AlterConfigBatchRequestDataData{Name: numPartitionsKey}
+		kafkaRestConfigs.Data = append(kafkaRestConfigs.Data, partitionsKafkaRestConfig)
+	} else if hasNumPartitionsChanged {
 		numPartitions, err := c.getNumPartitions(topicName)
 		if err != nil {
 			return err
@@ -1,7 +1,9 @@
 package kafka
 
 import (
+	"bufio"
 	"context"
+	"encoding/base64"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
@@ -10,7 +12,10 @@ import (
 	"os/signal"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/antihax/optional"
 	"github.com/confluentinc/confluent-kafka-go/kafka"
@@ -21,6 +26,8 @@ import (
 	sr "github.com/confluentinc/cli/internal/cmd/schema-registry"
 	configv1 "github.com/confluentinc/cli/internal/pkg/config/v1"
 	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/log"
+	"github.com/confluentinc/cli/internal/pkg/output"
 	"github.com/confluentinc/cli/internal/pkg/serdes"
 	"github.com/confluentinc/cli/internal/pkg/utils"
 )
@@ -32,8 +39,37 @@ const (
 	principalClaimNameKey = "principalClaimName"
 	principalKey          = "principal"
 	oauthConfig           = "principalClaimName=confluent principal=admin"
+
+	keyMode   = "key"
+	valueMode = "value"
 )
 
+// schemaFlags holds the names of the flags used to configure the (de)serialization of either the key or the value of a message.
+type schemaFlags struct {
+	format     string
+	schema     string
+	schemaId   string
+	references string
+}
+
+var schemaFlagsByMode = map[string]schemaFlags{
+	keyMode: {
+		format:     "key-format",
+		schema:     "key-schema",
+		schemaId:   "key-schema-id",
+		references: "key-references",
+	},
+	valueMode: {
+		format:     "value-format",
+		schema:     "schema",
+		schemaId:   "schema-id",
+		references: "references",
+	},
+}
+
+// schemaRegistryFlags are the flags which configure how schemas are looked up in or registered with Schema Registry.
+var schemaRegistryFlags = []string{"key-schema-id", "schema-id", "key-references", "references", "schema-registry-context", "schema-registry-endpoint", "schema-registry-api-key", "schema-registry-api-secret"}
+
 var (
 	// Regex for sasl.oauthbearer.config, which constrains it to be
 	// 1 or more name=value pairs with optional ignored whitespace
@@ -42,22 +78,111 @@ var (
 	oauthbearerNameEqualsValueRegex = regexp.MustCompile(`(\w+)\s*=\s*(\w+)`)
 )
 
+// validateNoSchemaRegistry reports whether messages are (de)serialized with local schema files instead of Schema Registry,
+// in which case each format other than "string" requires a schema file, and the Schema Registry flags are not used.
+func validateNoSchemaRegistry(cmd *cobra.Command) (bool, error) {
+	noSchemaRegistry, err := cmd.Flags().GetBool("no-schema-registry")
+	if err != nil || !noSchemaRegistry {
+		return false, err
+	}
+
+	for _, flag := range schemaRegistryFlags {
+		if cmd.Flags().Changed(flag) {
+			return false, errors.Errorf(errors.ProhibitedFlagCombinationErrorMsg, "no-schema-registry", flag)
+		}
+	}
+
+	for _, mode := range []string{keyMode, valueMode} {
+		flags := schemaFlagsByMode[mode]
+		format, err := cmd.Flags().GetString(flags.format)
+		if err != nil {
+			return false, err
+		}
+		schema, err := cmd.Flags().GetString(flags.schema)
+		if err != nil {
+			return false, err
+		}
+		if format != serdes.RAWSCHEMANAME && schema == "" {
+			return false, errors.Errorf(errors.LocalSchemaRequiredErrorMsg, flags.schema, format)
+		}
+	}
+
+	return true, nil
+}
+
+// getLocalSchemas returns the paths of the local schema files of a consumer by mode, if Schema Registry is not used.
+func getLocalSchemas(cmd *cobra.Command) (map[string]string, error) {
+	noSchemaRegistry, err := validateNoSchemaRegistry(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	localSchemas := map[string]string{}
+	for _, mode := range []string{keyMode, valueMode} {
+		flag := schemaFlagsByMode[mode].schema
+		schema, err := cmd.Flags().GetString(flag)
+		if err != nil {
+			return nil, err
+		}
+		if schema == "" {
+			continue
+		}
+		if !noSchemaRegistry {
+			return nil, errors.Errorf(errors.NoSchemaRegistryRequiredErrorMsg, flag)
+		}
+		localSchemas[mode] = schema
+	}
+
+	return localSchemas, nil
+}
+
 type ConsumerProperties struct {
 	Delimiter  string
 	FullHeader bool
 	PrintKey   bool
 	Timestamp  bool
 	SchemaPath string
+	Json       bool
+	Filters    []*messageFilter
+	Fields     []string
+}
+
+// base64Encoding marks the keys, values, and header values of a message envelope which are not valid UTF-8,
+// and are therefore base64-encoded so that they survive the round trip through JSON.
+const base64Encoding = "base64"
+
+// messageEnvelope is the JSON representation of a message, used by `kafka topic consume -o json` and `kafka topic produce --input-format jsonl`.
+type messageEnvelope struct {
+	Topic         string          `json:"topic,omitempty"`
+	Partition     int32           `json:"partition"`
+	Offset        int64           `json:"offset"`
+	Timestamp     int64           `json:"timestamp,omitempty"`
+	Headers       []messageHeader `json:"headers,omitempty"`
+	Key           json.RawMessage `json:"key"`
+	KeyEncoding   string          `json:"key_encoding,omitempty"`
+	Value         json.RawMessage `json:"value"`
+	ValueEncoding string          `json:"value_encoding,omitempty"`
+}
+
+type messageHeader struct {
+	Key      string  `json:"key"`
+	Value    *string `json:"value"`
+	Encoding string  `json:"encoding,omitempty"`
 }
 
 // GroupHandler instances are used to handle individual topic-partition claims.
 type GroupHandler struct {
 	SrClient   *srsdk.APIClient
 	Ctx        context.Context
+	KeyFormat  string
 	Format     string
 	Out        io.Writer
+	KeySubject string
 	Subject    string
 	Properties ConsumerProperties
+	// LocalSchemas holds the paths of local schema files by mode, which are used instead of Schema Registry
+	// to deserialize messages without its wire format.
+	LocalSchemas map[string]string
 }
 
 func (c *authenticatedTopicCommand) refreshOAuthBearerToken(cmd *cobra.Command, client ckafka.Handle) error {
@@ -156,7 +281,9 @@ func newOnPremConsumer(cmd *cobra.Command, clientID string, configPath string, c
 }
 
 // example: https://github.com/confluentinc/confluent-kafka-go/blob/e01dd295220b5bf55f3fbfabdf8cc6d3f0ae185f/examples/cooperative_consumer_example/cooperative_consumer_example.go#L121
-func getRebalanceCallback(cmd *cobra.Command, offset ckafka.Offset, partitionFilter partitionFilter) func(*ckafka.Consumer, ckafka.Event) error {
+// The consumer ignores errors returned by the callback, so errors which would leave consumption hanging are also recorded in bounds,
+// to be returned by pollMessages instead.
+func getRebalanceCallback(cmd *cobra.Command, offset ckafka.Offset, timestamp int64, partitionFilter partitionFilter, bounds *consumeBounds) func(*ckafka.Consumer, ckafka.Event) error {
 	return func(consumer *ckafka.Consumer, event ckafka.Event) error {
 		switch ev := event.(type) { // ev is of type ckafka.Event
 		case kafka.AssignedPartitions:
@@ -168,10 +295,24 @@ func getRebalanceCallback(cmd *cobra.Command, offset ckafka.Offset, partitionFil
 			}
 			partitions = getPartitionsByIndex(partitions, partitionFilter)
 
+			if timestamp >= 0 {
+				var err error
+				partitions, err = getOffsetsForTimestamp(consumer, partitions, timestamp)
+				if err != nil {
+					bounds.fail(err)
+					return err
+				}
+			}
+
 			err := consumer.IncrementalAssign(partitions)
 			if err != nil {
 				return err
 			}
+
+			if err := bounds.assign(consumer, partitions); err != nil {
+				bounds.fail(err)
+				return err
+			}
 		case kafka.RevokedPartitions:
 			if consumer.AssignmentLost() {
 				utils.ErrPrintln(cmd, "%% Current assignment lost.")
@@ -181,45 +322,54 @@ func getRebalanceCallback(cmd *cobra.Command, offset ckafka.Offset, partitionFil
 			if err != nil {
 				return err
 			}
+			bounds.revoke(parts)
 		}
 		return nil
 	}
 }
 
-func consumeMessage(e *ckafka.Message, h *GroupHandler) error {
-	value := e.Value
-	if h.Properties.PrintKey {
-		key := e.Key
-		var keyString string
-		if len(key) == 0 {
-			keyString = "null"
-		} else {
-			keyString = string(key)
+// consumeMessage prints a message if it matches every filter, and reports whether it was printed.
+func consumeMessage(e *ckafka.Message, h *GroupHandler) (bool, error) {
+	fields := newMessageFields(e, h)
+	for _, filter := range h.Properties.Filters {
+		if matches, err := filter.matches(fields); err != nil || !matches {
+			return false, err
 		}
-		_, err := fmt.Fprint(h.Out, keyString+h.Properties.Delimiter)
+	}
+
+	if len(h.Properties.Fields) > 0 {
+		projection, err := projectMessageFields(fields, h.Properties.Fields)
 		if err != nil {
-			return err
+			return false, err
 		}
+		_, err = fmt.Fprintln(h.Out, projection)
+		return true, err
 	}
 
-	deserializationProvider, err := serdes.GetDeserializationProvider(h.Format)
-	if err != nil {
-		return err
+	return true, printMessage(e, h)
+}
+
+func printMessage(e *ckafka.Message, h *GroupHandler) error {
+	if h.Properties.Json {
+		return consumeMessageAsJson(e, h)
 	}
 
-	if h.Format != "string" {
-		schemaPath, referencePathMap, err := h.RequestSchema(value)
-		if err != nil {
-			return err
+	if h.Properties.PrintKey {
+		keyString := "null"
+		if len(e.Key) > 0 {
+			var err error
+			keyString, err = h.deserialize(e.Key, keyMode)
+			if err != nil {
+				return err
+			}
 		}
-		// Message body is encoded after 5 bytes of meta information.
-		value = value[messageOffset:]
-		err = deserializationProvider.LoadSchema(schemaPath, referencePathMap)
+		_, err := fmt.Fprint(h.Out, keyString+h.Properties.Delimiter)
 		if err != nil {
 			return err
 		}
 	}
-	jsonMessage, err := serdes.Deserialize(deserializationProvider, value)
+
+	jsonMessage, err := h.deserialize(e.Value, valueMode)
 	if err != nil {
 		return err
 	}
@@ -247,29 +397,147 @@ func consumeMessage(e *ckafka.Message, h *GroupHandler) error {
 	return nil
 }
 
-func runConsumer(cmd *cobra.Command, consumer *ckafka.Consumer, groupHandler *GroupHandler) error {
+func consumeMessageAsJson(e *ckafka.Message, h *GroupHandler) error {
+	envelope := &messageEnvelope{
+		Partition: e.TopicPartition.Partition,
+		Offset:    int64(e.TopicPartition.Offset),
+		Key:       json.RawMessage("null"),
+		Value:     json.RawMessage("null"),
+	}
+	if e.TopicPartition.Topic != nil {
+		envelope.Topic = *e.TopicPartition.Topic
+	}
+	if !e.Timestamp.IsZero() {
+		envelope.Timestamp = e.Timestamp.UnixMilli()
+	}
+
+	for _, header := range e.Headers {
+		messageHeader := messageHeader{Key: header.Key}
+		if header.Value != nil {
+			value, encoding := encodeString(string(header.Value))
+			messageHeader.Value = &value
+			messageHeader.Encoding = encoding
+		}
+		envelope.Headers = append(envelope.Headers, messageHeader)
+	}
+
+	if len(e.Key) > 0 {
+		key, err := h.deserialize(e.Key, keyMode)
+		if err != nil {
+			return err
+		}
+		envelope.Key, envelope.KeyEncoding, err = toRawMessage(key, h.KeyFormat)
+		if err != nil {
+			return err
+		}
+	}
+
+	if e.Value != nil {
+		value, err := h.deserialize(e.Value, valueMode)
+		if err != nil {
+			return err
+		}
+		envelope.Value, envelope.ValueEncoding, err = toRawMessage(value, h.Format)
+		if err != nil {
+			return err
+		}
+	}
+
+	out, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(h.Out, string(out))
+	return err
+}
+
+// toRawMessage embeds schema-based message data as JSON, and everything else as a JSON string,
+// which is base64-encoded if the data is not valid UTF-8. It also returns the encoding of the data, if any.
+func toRawMessage(data, format string) (json.RawMessage, string, error) {
+	if format != "" && format != serdes.RAWSCHEMANAME && json.Valid([]byte(data)) {
+		return json.RawMessage(data), "", nil
+	}
+
+	str, encoding := encodeString(data)
+	raw, err := json.Marshal(str)
+	return raw, encoding, err
+}
+
+// encodeString base64-encodes data which is not valid UTF-8, since JSON strings would replace its invalid bytes.
+func encodeString(data string) (string, string) {
+	if utf8.ValidString(data) {
+		return data, ""
+	}
+	return base64.StdEncoding.EncodeToString([]byte(data)), base64Encoding
+}
+
+// decodeString reverses encodeString.
+func decodeString(data, encoding string) (string, error) {
+	switch encoding {
+	case "":
+		return data, nil
+	case base64Encoding:
+		decoded, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return "", errors.Errorf(errors.InvalidMessageEncodingErrorMsg, encoding, err)
+		}
+		return string(decoded), nil
+	default:
+		return "", errors.Errorf(errors.UnknownMessageEncodingErrorMsg, encoding, base64Encoding)
+	}
+}
+
+func runConsumer(cmd *cobra.Command, consumer *ckafka.Consumer, groupHandler *GroupHandler, bounds *consumeBounds) error {
+	return pollMessages(cmd, consumer, bounds, groupHandler.Out, func(e *ckafka.Message) (bool, error) {
+		return consumeMessage(e, groupHandler)
+	})
+}
+
+// pollMessages passes each message within bounds to handleMessage, which reports whether the message counts towards the message limit.
+// The consumer is left open for the caller to close, whether or not polling fails.
+func pollMessages(cmd *cobra.Command, consumer *ckafka.Consumer, bounds *consumeBounds, out io.Writer, handleMessage func(*ckafka.Message) (bool, error)) error {
 	run := true
 	signals := make(chan os.Signal, 1)
 	signal.Notify(signals, os.Interrupt)
+	lastMessage := time.Now()
 	for run {
 		select {
 		case <-signals: // Trap SIGINT to trigger a shutdown.
 			utils.ErrPrintln(cmd, errors.StoppingConsumerMsg)
-			consumer.Close()
 			run = false
 		default:
+			if bounds.err != nil {
+				return bounds.err
+			}
+			if bounds.isDone() {
+				return nil
+			}
+			if bounds.isTimedOut(lastMessage) {
+				if bounds.failOnTimeout {
+					return errors.Errorf(errors.ConsumeTimeoutErrorMsg, bounds.timeout)
+				}
+				return nil
+			}
+
 			event := consumer.Poll(100) // polling event from consumer with a timeout of 100ms
 			if event == nil {
 				continue
 			}
 			switch e := event.(type) {
 			case *ckafka.Message:
-				err := consumeMessage(e, groupHandler)
+				lastMessage = time.Now()
+				if !bounds.accept(e) {
+					continue
+				}
+				handled, err := handleMessage(e)
 				if err != nil {
 					return err
 				}
+				bounds.record(e, handled)
+			case ckafka.PartitionEOF:
+				bounds.reachEnd(ckafka.TopicPartition(e))
 			case ckafka.Error:
-				fmt.Fprintf(groupHandler.Out, "%% Error: %v: %v\n", e.Code(), e)
+				fmt.Fprintf(out, "%% Error: %v: %v\n", e.Code(), e)
 				if e.Code() == ckafka.ErrAllBrokersDown {
 					run = false
 				}
@@ -279,7 +547,225 @@ func runConsumer(cmd *cobra.Command, consumer *ckafka.Consumer, groupHandler *Gr
 	return nil
 }
 
+// deserialize decodes either the key or the value of a message, fetching its schema from Schema Registry if needed,
+// unless a local schema file is used instead.
+func (h *GroupHandler) deserialize(data []byte, mode string) (string, error) {
+	format, subject := h.Format, h.Subject
+	if mode == keyMode {
+		format, subject = h.KeyFormat, h.KeySubject
+	}
+	if format == "" {
+		format = serdes.RAWSCHEMANAME
+	}
+
+	deserializationProvider, err := serdes.GetDeserializationProvider(format)
+	if err != nil {
+		return "", err
+	}
+
+	if schemaPath, ok := h.LocalSchemas[mode]; ok && format != serdes.RAWSCHEMANAME {
+		if err := deserializationProvider.LoadSchema(schemaPath, map[string]string{}); err != nil {
+			return "", err
+		}
+		return serdes.DeserializePlain(deserializationProvider, data)
+	}
+
+	if format != serdes.RAWSCHEMANAME {
+		schemaPath, referencePathMap, err := h.requestSchema(data, subject)
+		if err != nil {
+			return "", err
+		}
+		// Message body is encoded after 5 bytes of meta information.
+		data = data[messageOffset:]
+		err = deserializationProvider.LoadSchema(schemaPath, referencePathMap)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return serdes.Deserialize(deserializationProvider, data)
+}
+
+type produceSummary struct {
+	Sent       int64           `json:"sent" yaml:"sent"`
+	Failed     int64           `json:"failed" yaml:"failed"`
+	Partitions map[int32]int64 `json:"partitions" yaml:"partitions"`
+	DurationMs int64           `json:"duration_ms" yaml:"duration_ms"`
+	Throughput float64         `json:"throughput" yaml:"throughput"` // messages per second
+}
+
+// runProducer produces a message for each line of input, skipping lines for which getMessage returns no message.
+// Messages are produced asynchronously, with at most maxInFlight messages awaiting a delivery report.
+func runProducer(cmd *cobra.Command, producer *ckafka.Producer, topic string, maxInFlight int, in io.Reader, getMessage func(string) (*ckafka.Message, error)) error {
+	// Line reader for producer input.
+	scanner := bufio.NewScanner(in)
+	// CCloud Kafka messageMaxBytes:
+	// https://github.com/confluentinc/cc-spec-kafka/blob/9f0af828d20e9339aeab6991f32d8355eb3f0776/plugins/kafka/kafka.go#L43.
+	const maxScanTokenSize = 1024*1024*2 + 12
+	scanner.Buffer(nil, maxScanTokenSize)
+
+	return produceMessages(cmd, producer, topic, maxInFlight, func() (*ckafka.Message, error) {
+		for scanner.Scan() {
+			if len(scanner.Text()) == 0 {
+				continue
+			}
+			if msg, err := getMessage(scanner.Text()); err != nil || msg != nil {
+				return msg, err
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	})
+}
+
+// produceMessages produces each message returned by next until it returns io.EOF, printing a summary once every message is delivered.
+// Messages are produced asynchronously, with at most maxInFlight messages awaiting a delivery report.
+func produceMessages(cmd *cobra.Command, producer *ckafka.Producer, topic string, maxInFlight int, next func() (*ckafka.Message, error)) error {
+	if maxInFlight < 1 {
+		return errors.New(errors.InvalidMaxInFlightErrorMsg)
+	}
+
+	type nextMessage struct {
+		msg *ckafka.Message
+		err error
+	}
+	input := make(chan nextMessage, 1)
+	// Avoid blocking in for loop so ^C or ^D can exit immediately.
+	read := func() {
+		msg, err := next()
+		input <- nextMessage{msg: msg, err: err}
+	}
+
+	// Trap SIGINT to trigger a shutdown.
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	defer signal.Stop(signals)
+	// Prime reader
+	go read()
+
+	summary := &produceSummary{Partitions: map[int32]int64{}}
+	var mu sync.Mutex
+	var produceErr error
+	onFailure := func(offset ckafka.Offset, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		summary.Failed++
+		if isProduceToCompactedTopicError, err := errors.CatchProduceToCompactedTopicError(err, topic); isProduceToCompactedTopicError {
+			if produceErr == nil {
+				produceErr = err
+			}
+			return
+		}
+		utils.ErrPrintf(cmd, errors.FailedToProduceErrorMsg, offset, err)
+	}
+
+	// Delivery reports are handled in the background so that producing never waits on a round trip to the broker.
+	inFlight := make(chan struct{}, maxInFlight)
+	var wg sync.WaitGroup
+	go func() {
+		for e := range producer.Events() {
+			m, ok := e.(*ckafka.Message)
+			if !ok {
+				if err, ok := e.(ckafka.Error); ok {
+					log.CliLogger.Warnf("Producer error: %v", err)
+				}
+				continue
+			}
+			if m.TopicPartition.Error != nil {
+				onFailure(m.TopicPartition.Offset, m.TopicPartition.Error)
+			} else {
+				mu.Lock()
+				summary.Sent++
+				summary.Partitions[m.TopicPartition.Partition]++
+				mu.Unlock()
+			}
+			<-inFlight
+			wg.Done()
+		}
+	}()
+
+	start := time.Now()
+	var readErr error
+loop:
+	for {
+		var msg *ckafka.Message
+		select {
+		case <-signals:
+			break loop
+		case next := <-input:
+			if next.err != nil {
+				if next.err != io.EOF {
+					readErr = next.err
+				}
+				break loop
+			}
+			msg = next.msg
+		}
+
+		mu.Lock()
+		err := produceErr
+		mu.Unlock()
+		if err != nil {
+			break
+		}
+
+		inFlight <- struct{}{}
+		wg.Add(1)
+		if err := producer.Produce(msg, nil); err != nil {
+			onFailure(msg.TopicPartition.Offset, err)
+			<-inFlight
+			wg.Done()
+		}
+		go read()
+	}
+
+	// Wait for every outstanding message to be delivered, honoring any linger or batching settings.
+	for remaining := producer.Flush(100); remaining > 0; remaining = producer.Flush(100) {
+		log.CliLogger.Tracef("Waiting for %d messages to be delivered", remaining)
+	}
+	wg.Wait()
+
+	summary.DurationMs = time.Since(start).Milliseconds()
+	if seconds := time.Since(start).Seconds(); seconds > 0 {
+		summary.Throughput = float64(summary.Sent) / seconds
+	}
+	if err := printProduceSummary(cmd, summary); err != nil {
+		return err
+	}
+
+	if readErr != nil {
+		return readErr
+	}
+	return produceErr
+}
+
+func printProduceSummary(cmd *cobra.Command, summary *produceSummary) error {
+	if output.GetFormat(cmd).IsSerialized() {
+		return output.SerializedOutput(cmd, summary)
+	}
+
+	duration := time.Duration(summary.DurationMs) * time.Millisecond
+	utils.ErrPrintf(cmd, errors.ProducerSummaryMsg, summary.Sent, summary.Failed, duration, summary.Throughput)
+
+	partitions := make([]int32, 0, len(summary.Partitions))
+	for partition := range summary.Partitions {
+		partitions = append(partitions, partition)
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+	for _, partition := range partitions {
+		utils.ErrPrintf(cmd, errors.ProducerPartitionMsg, partition, summary.Partitions[partition])
+	}
+
+	return nil
+}
+
 func (h *GroupHandler) RequestSchema(value []byte) (string, map[string]string, error) {
+	return h.requestSchema(value, h.Subject)
+}
+
+func (h *GroupHandler) requestSchema(value []byte, subject string) (string, map[string]string, error) {
 	if len(value) < messageOffset {
 		return "", nil, errors.New(errors.FailedToFindSchemaIDErrorMsg)
 	}
@@ -288,13 +774,13 @@ func (h *GroupHandler) RequestSchema(value []byte) (string, map[string]string, e
 	schemaID := int32(binary.BigEndian.Uint32(value[1:messageOffset])) // schema id is stored as a part of message meta info
 
 	// Create temporary file to store schema retrieved (also for cache). Retry if get error retriving schema or writing temp schema file
-	tempStorePath := filepath.Join(h.Properties.SchemaPath, fmt.Sprintf("%s-%d.txt", h.Subject, schemaID))
-	tempRefStorePath := filepath.Join(h.Properties.SchemaPath, fmt.Sprintf("%s-%d.ref", h.Subject, schemaID))
+	tempStorePath := filepath.Join(h.Properties.SchemaPath, fmt.Sprintf("%s-%d.txt", subject, schemaID))
+	tempRefStorePath := filepath.Join(h.Properties.SchemaPath, fmt.Sprintf("%s-%d.ref", subject, schemaID))
 	var references []srsdk.SchemaReference
 	if !utils.FileExists(tempStorePath) || !utils.FileExists(tempRefStorePath) {
 		// TODO: add handler for writing schema failure
 		getSchemaOpts := srsdk.GetSchemaOpts{
-			Subject: optional.NewString(h.Subject),
+			Subject: optional.NewString(subject),
 		}
 		schemaString, _, err := h.SrClient.DefaultApi.GetSchema(h.Ctx, schemaID, &getSchemaOpts)
 		if err != nil {
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
+	"time"
 
 	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
 	"github.com/google/uuid"
@@ -63,6 +65,10 @@ func getConsumerConfigMap(group string, kafka *configv1.KafkaClusterConfig, clie
 	if err := configMap.SetKey("partition.assignment.strategy", "cooperative-sticky"); err != nil {
 		return nil, err
 	}
+	// PartitionEOF events tell `--to-end` that a partition has been consumed, even if its last offsets hold no messages.
+	if err := configMap.SetKey("enable.partition.eof", true); err != nil {
+		return nil, err
+	}
 	if err := setConsumerDebugOption(configMap); err != nil {
 		return nil, err
 	}
@@ -131,6 +137,10 @@ func getOnPremConsumerConfigMap(cmd *cobra.Command, clientID string) (*ckafka.Co
 	if err := configMap.SetKey("partition.assignment.strategy", "cooperative-sticky"); err != nil {
 		return nil, err
 	}
+	// PartitionEOF events tell `--to-end` that a partition has been consumed, even if its last offsets hold no messages.
+	if err := configMap.SetKey("enable.partition.eof", true); err != nil {
+		return nil, err
+	}
 
 	if err := setConsumerDebugOption(configMap); err != nil {
 		return nil, err
@@ -260,6 +270,67 @@ func getOffsetWithFallback(cmd *cobra.Command) (ckafka.Offset, error) {
 	}
 }
 
+// getStartTimestamp returns the timestamp in milliseconds from which to consume, or -1 if consumption should start at an offset instead.
+func getStartTimestamp(cmd *cobra.Command) (int64, error) {
+	if cmd.Flags().Changed("from-timestamp") {
+		fromTimestamp, err := cmd.Flags().GetString("from-timestamp")
+		if err != nil {
+			return -1, err
+		}
+		return parseTimestamp(fromTimestamp)
+	}
+
+	if cmd.Flags().Changed("since") {
+		since, err := cmd.Flags().GetDuration("since")
+		if err != nil {
+			return -1, err
+		}
+		if since <= 0 {
+			return -1, errors.New(errors.InvalidSinceErrorMsg)
+		}
+		return time.Now().Add(-since).UnixMilli(), nil
+	}
+
+	return -1, nil
+}
+
+// parseTimestamp parses either an RFC 3339 timestamp or a number of milliseconds since the epoch.
+func parseTimestamp(timestamp string) (int64, error) {
+	if ms, err := strconv.ParseInt(timestamp, 10, 64); err == nil && ms >= 0 {
+		return ms, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return -1, errors.Errorf(errors.InvalidTimestampErrorMsg, timestamp)
+	}
+	return t.UnixMilli(), nil
+}
+
+// getOffsetsForTimestamp resolves the earliest offset of each partition whose message timestamp is at or after the given timestamp.
+func getOffsetsForTimestamp(consumer *ckafka.Consumer, partitions []ckafka.TopicPartition, timestamp int64) ([]ckafka.TopicPartition, error) {
+	if len(partitions) == 0 {
+		return partitions, nil
+	}
+
+	times := make([]ckafka.TopicPartition, len(partitions))
+	for i, partition := range partitions {
+		partition.Offset = ckafka.Offset(timestamp)
+		times[i] = partition
+	}
+
+	offsets, err := consumer.OffsetsForTimes(times, 10000)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.ResolveTimestampOffsetsErrorMsg, timestamp)
+	}
+	for _, offset := range offsets {
+		if offset.Error != nil {
+			return nil, errors.Wrapf(offset.Error, errors.ResolveTimestampOffsetsErrorMsg, timestamp)
+		}
+	}
+	return offsets, nil
+}
+
 func getPartitionsByIndex(partitions []ckafka.TopicPartition, partitionFilter partitionFilter) []ckafka.TopicPartition {
 	if partitionFilter.changed {
 		for _, partition := range partitions {
@@ -347,3 +418,167 @@ func overwriteKafkaClientConfigs(configMap *ckafka.ConfigMap, configPath string,
 
 	return nil
 }
+
+// consumeBounds holds the conditions under which `kafka topic consume` stops on its own.
+type consumeBounds struct {
+	maxMessages   int
+	untilOffset   int64
+	toEnd         bool
+	timeout       time.Duration
+	failOnTimeout bool
+
+	consumed   int
+	assigned   bool
+	endOffsets map[int32]int64 // The offset at which each assigned partition stops being consumed.
+	err        error           // The first error of the rebalance callback, whose return value is ignored by the consumer.
+}
+
+func getConsumeBounds(cmd *cobra.Command) (*consumeBounds, error) {
+	maxMessages, err := cmd.Flags().GetInt("max-messages")
+	if err != nil {
+		return nil, err
+	}
+	if maxMessages < 0 {
+		return nil, errors.New(errors.InvalidMaxMessagesErrorMsg)
+	}
+
+	untilOffset := int64(-1)
+	if cmd.Flags().Changed("until-offset") {
+		untilOffset, err = cmd.Flags().GetInt64("until-offset")
+		if err != nil {
+			return nil, err
+		}
+		if untilOffset < 0 {
+			return nil, errors.New(errors.InvalidOffsetErrorMsg)
+		}
+	}
+
+	toEnd, err := cmd.Flags().GetBool("to-end")
+	if err != nil {
+		return nil, err
+	}
+
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return nil, err
+	}
+
+	failOnTimeout, err := cmd.Flags().GetBool("fail-on-timeout")
+	if err != nil {
+		return nil, err
+	}
+	if failOnTimeout && timeout == 0 {
+		return nil, errors.New(errors.FailOnTimeoutWithoutTimeoutErrorMsg)
+	}
+
+	return &consumeBounds{
+		maxMessages:   maxMessages,
+		untilOffset:   untilOffset,
+		toEnd:         toEnd,
+		timeout:       timeout,
+		failOnTimeout: failOnTimeout,
+		endOffsets:    map[int32]int64{},
+	}, nil
+}
+
+// hasEndOffsets reports whether consumption stops once every assigned partition reaches a given offset.
+func (b *consumeBounds) hasEndOffsets() bool {
+	return b.toEnd || b.untilOffset >= 0
+}
+
+// assign computes the end offset of each newly assigned partition, starting from the offsets they are assigned at.
+func (b *consumeBounds) assign(consumer *ckafka.Consumer, partitions []ckafka.TopicPartition) error {
+	if !b.hasEndOffsets() {
+		return nil
+	}
+	b.assigned = true
+
+	for _, partition := range partitions {
+		low, high, err := consumer.QueryWatermarkOffsets(*partition.Topic, partition.Partition, 10000)
+		if err != nil {
+			return err
+		}
+
+		end := b.untilOffset
+		if b.toEnd && (end < 0 || high < end) {
+			end = high
+		}
+
+		start := int64(partition.Offset)
+		switch partition.Offset {
+		case ckafka.OffsetBeginning:
+			start = low
+		case ckafka.OffsetEnd:
+			start = high
+		}
+
+		if start >= end {
+			log.CliLogger.Debugf("Partition %d has no messages to consume before offset %d", partition.Partition, end)
+			continue
+		}
+		b.endOffsets[partition.Partition] = end
+	}
+
+	return nil
+}
+
+func (b *consumeBounds) revoke(partitions []ckafka.TopicPartition) {
+	for _, partition := range partitions {
+		delete(b.endOffsets, partition.Partition)
+	}
+}
+
+// accept reports whether a message is within bounds and should be consumed.
+func (b *consumeBounds) accept(msg *ckafka.Message) bool {
+	if !b.hasEndOffsets() {
+		return true
+	}
+
+	end, ok := b.endOffsets[msg.TopicPartition.Partition]
+	if !ok {
+		return false
+	}
+	if int64(msg.TopicPartition.Offset) >= end {
+		delete(b.endOffsets, msg.TopicPartition.Partition)
+		return false
+	}
+	return true
+}
+
+// record tracks a consumed message, which only counts towards the message limit if it was printed.
+func (b *consumeBounds) record(msg *ckafka.Message, printed bool) {
+	if printed {
+		b.consumed++
+	}
+
+	if end, ok := b.endOffsets[msg.TopicPartition.Partition]; ok && int64(msg.TopicPartition.Offset)+1 >= end {
+		delete(b.endOffsets, msg.TopicPartition.Partition)
+	}
+}
+
+// reachEnd stops consuming a partition which has no more messages, which may happen before its end offset
+// if the last offsets of the partition hold transaction markers or compacted messages.
+func (b *consumeBounds) reachEnd(partition ckafka.TopicPartition) {
+	if b.toEnd {
+		delete(b.endOffsets, partition.Partition)
+	}
+}
+
+// fail records an error of the rebalance callback, to be returned by pollMessages.
+func (b *consumeBounds) fail(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// isDone reports whether the message limit has been reached, or every assigned partition has reached its end offset.
+func (b *consumeBounds) isDone() bool {
+	if b.maxMessages > 0 && b.consumed >= b.maxMessages {
+		return true
+	}
+	return b.assigned && len(b.endOffsets) == 0
+}
+
+func (b *consumeBounds) isTimedOut(lastMessage time.Time) bool {
+	return b.timeout > 0 && time.Since(lastMessage) >= b.timeout
+}
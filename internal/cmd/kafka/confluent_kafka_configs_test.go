@@ -0,0 +1,108 @@
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/internal/pkg/errors"
+)
+
+func newTestMessage(partition int32, offset int64) *ckafka.Message {
+	topic := "my-topic"
+	return &ckafka.Message{TopicPartition: ckafka.TopicPartition{Topic: &topic, Partition: partition, Offset: ckafka.Offset(offset)}}
+}
+
+func TestConsumeBounds_MaxMessages(t *testing.T) {
+	req := require.New(t)
+
+	bounds := &consumeBounds{maxMessages: 2, untilOffset: -1, endOffsets: map[int32]int64{}}
+	req.False(bounds.isDone())
+
+	for _, msg := range []*ckafka.Message{newTestMessage(0, 0), newTestMessage(1, 0)} {
+		req.True(bounds.accept(msg))
+		bounds.record(msg, true)
+	}
+	req.True(bounds.isDone())
+}
+
+func TestConsumeBounds_EndOffsets(t *testing.T) {
+	req := require.New(t)
+
+	bounds := &consumeBounds{untilOffset: 2, assigned: true, endOffsets: map[int32]int64{0: 2, 1: 2}}
+	req.False(bounds.isDone())
+
+	for _, msg := range []*ckafka.Message{newTestMessage(0, 0), newTestMessage(0, 1)} {
+		req.True(bounds.accept(msg))
+		bounds.record(msg, true)
+	}
+	req.False(bounds.isDone())
+
+	req.False(bounds.accept(newTestMessage(0, 2)))
+	req.False(bounds.accept(newTestMessage(1, 5)))
+	req.True(bounds.isDone())
+}
+
+func TestConsumeBounds_Revoke(t *testing.T) {
+	req := require.New(t)
+
+	bounds := &consumeBounds{toEnd: true, untilOffset: -1, assigned: true, endOffsets: map[int32]int64{0: 10}}
+	req.False(bounds.isDone())
+
+	bounds.revoke([]ckafka.TopicPartition{newTestMessage(0, 0).TopicPartition})
+	req.True(bounds.isDone())
+}
+
+func TestConsumeBounds_ReachEnd(t *testing.T) {
+	req := require.New(t)
+
+	bounds := &consumeBounds{untilOffset: 20, assigned: true, endOffsets: map[int32]int64{0: 20}}
+	bounds.reachEnd(newTestMessage(0, 10).TopicPartition)
+	req.False(bounds.isDone())
+
+	bounds = &consumeBounds{toEnd: true, untilOffset: -1, assigned: true, endOffsets: map[int32]int64{0: 10, 1: 10}}
+	req.True(bounds.accept(newTestMessage(0, 7)))
+	bounds.record(newTestMessage(0, 7), true)
+	bounds.reachEnd(newTestMessage(0, 10).TopicPartition)
+	req.False(bounds.isDone())
+
+	bounds.reachEnd(newTestMessage(1, 10).TopicPartition)
+	req.True(bounds.isDone())
+}
+
+func TestConsumeBounds_Fail(t *testing.T) {
+	req := require.New(t)
+
+	bounds := &consumeBounds{untilOffset: -1}
+	bounds.fail(errors.New("first"))
+	bounds.fail(errors.New("second"))
+	req.EqualError(bounds.err, "first")
+}
+
+func TestConsumeBounds_Timeout(t *testing.T) {
+	req := require.New(t)
+
+	bounds := &consumeBounds{untilOffset: -1}
+	req.False(bounds.isTimedOut(time.Now().Add(-time.Hour)))
+
+	bounds.timeout = time.Minute
+	req.False(bounds.isTimedOut(time.Now()))
+	req.True(bounds.isTimedOut(time.Now().Add(-time.Hour)))
+}
+
+func TestParseTimestamp(t *testing.T) {
+	req := require.New(t)
+
+	timestamp, err := parseTimestamp("1672531200000")
+	req.NoError(err)
+	req.Equal(int64(1672531200000), timestamp)
+
+	timestamp, err = parseTimestamp("2023-01-01T01:00:00+01:00")
+	req.NoError(err)
+	req.Equal(int64(1672531200000), timestamp)
+
+	_, err = parseTimestamp("yesterday")
+	req.EqualError(err, `invalid timestamp "yesterday": must be in RFC 3339 format (for example, "2006-01-02T15:04:05Z") or milliseconds since the epoch`)
+}
@@ -0,0 +1,37 @@
+package kafka
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+)
+
+func TestPrintProduceSummary(t *testing.T) {
+	req := require.New(t)
+
+	summary := &produceSummary{
+		Sent:       3,
+		Failed:     1,
+		Partitions: map[int32]int64{1: 2, 0: 1},
+		DurationMs: 1500,
+		Throughput: 2,
+	}
+
+	cmd := &cobra.Command{}
+	pcmd.AddOutputFlag(cmd)
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+
+	req.NoError(printProduceSummary(cmd, summary))
+	req.Equal("Produced 3 messages (1 failed) in 1.5s (2.0 messages/s).\nPartition 0: 1 messages\nPartition 1: 2 messages\n", out.String())
+
+	out.Reset()
+	req.NoError(cmd.Flags().Set("output", "json"))
+	req.NoError(printProduceSummary(cmd, summary))
+	req.JSONEq(`{"sent":3,"failed":1,"partitions":{"0":1,"1":2},"duration_ms":1500,"throughput":2}`, out.String())
+}
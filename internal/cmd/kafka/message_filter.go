@@ -0,0 +1,275 @@
+package kafka
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	"github.com/confluentinc/cli/internal/pkg/errors"
+)
+
+var filterOperators = []string{"==", "!=", "=~", "!~"}
+
+// messageFilter matches a field of a consumed message against a value, such as `value.user.id==42`.
+type messageFilter struct {
+	field    string
+	operator string
+	value    string
+	regex    *regexp.Regexp
+}
+
+func parseMessageFilters(expressions []string) ([]*messageFilter, error) {
+	filters := make([]*messageFilter, len(expressions))
+	for i, expression := range expressions {
+		filter, err := parseMessageFilter(expression)
+		if err != nil {
+			return nil, err
+		}
+		filters[i] = filter
+	}
+	return filters, nil
+}
+
+func parseMessageFilter(expression string) (*messageFilter, error) {
+	index, operator := -1, ""
+	for _, op := range filterOperators {
+		if i := strings.Index(expression, op); i != -1 && (index == -1 || i < index) {
+			index, operator = i, op
+		}
+	}
+	if index == -1 {
+		return nil, errors.Errorf(errors.InvalidFilterErrorMsg, expression)
+	}
+
+	filter := &messageFilter{
+		field:    strings.TrimSpace(expression[:index]),
+		operator: operator,
+		value:    strings.TrimSpace(expression[index+len(operator):]),
+	}
+	if err := validateMessageField(filter.field); err != nil {
+		return nil, err
+	}
+
+	if operator == "=~" || operator == "!~" {
+		regex, err := regexp.Compile(filter.value)
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidFilterErrorMsg, expression)
+		}
+		filter.regex = regex
+	}
+
+	return filter, nil
+}
+
+func (f *messageFilter) matches(fields *messageFields) (bool, error) {
+	value, ok, err := fields.get(f.field)
+	if err != nil {
+		return false, err
+	}
+
+	switch f.operator {
+	case "==":
+		return ok && toComparableString(value) == f.value, nil
+	case "!=":
+		return !ok || toComparableString(value) != f.value, nil
+	case "=~":
+		return ok && f.regex.MatchString(toComparableString(value)), nil
+	default:
+		return !ok || !f.regex.MatchString(toComparableString(value)), nil
+	}
+}
+
+func validateMessageFields(fields []string) error {
+	for _, field := range fields {
+		if err := validateMessageField(field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateMessageField(field string) error {
+	switch field {
+	case "topic", "partition", "offset", "timestamp", "key", "value":
+		return nil
+	}
+
+	for _, prefix := range []string{"header.", "key.", "value."} {
+		if strings.HasPrefix(field, prefix) && len(field) > len(prefix) {
+			return nil
+		}
+	}
+
+	return errors.Errorf(errors.InvalidMessageFieldErrorMsg, field)
+}
+
+// messageFields lazily deserializes the key and value of a consumed message to look up its fields.
+type messageFields struct {
+	msg     *ckafka.Message
+	handler *GroupHandler
+
+	key, value             interface{}
+	keyLoaded, valueLoaded bool
+}
+
+func newMessageFields(msg *ckafka.Message, handler *GroupHandler) *messageFields {
+	return &messageFields{msg: msg, handler: handler}
+}
+
+// get returns the value of a field, and whether the message has that field.
+func (m *messageFields) get(field string) (interface{}, bool, error) {
+	switch field {
+	case "topic":
+		if m.msg.TopicPartition.Topic == nil {
+			return nil, false, nil
+		}
+		return *m.msg.TopicPartition.Topic, true, nil
+	case "partition":
+		return int64(m.msg.TopicPartition.Partition), true, nil
+	case "offset":
+		return int64(m.msg.TopicPartition.Offset), true, nil
+	case "timestamp":
+		return m.msg.Timestamp.UnixMilli(), true, nil
+	}
+
+	if name := strings.TrimPrefix(field, "header."); name != field {
+		for _, header := range m.msg.Headers {
+			if header.Key == name {
+				if header.Value == nil {
+					return nil, true, nil
+				}
+				return string(header.Value), true, nil
+			}
+		}
+		return nil, false, nil
+	}
+
+	root, path, _ := strings.Cut(field, ".")
+	var data interface{}
+	switch root {
+	case "key":
+		if !m.keyLoaded {
+			key, err := m.decode(m.msg.Key, keyMode)
+			if err != nil {
+				return nil, false, err
+			}
+			m.key, m.keyLoaded = key, true
+		}
+		data = m.key
+	case "value":
+		if !m.valueLoaded {
+			value, err := m.decode(m.msg.Value, valueMode)
+			if err != nil {
+				return nil, false, err
+			}
+			m.value, m.valueLoaded = value, true
+		}
+		data = m.value
+	}
+
+	if path == "" {
+		return data, true, nil
+	}
+	value, ok := lookupPath(data, path)
+	return value, ok, nil
+}
+
+// decode deserializes the key or value of a message, parsing it as JSON when possible.
+func (m *messageFields) decode(data []byte, mode string) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	str, err := m.handler.deserialize(data, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewBufferString(str))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil || decoder.More() {
+		return str, nil
+	}
+	return v, nil
+}
+
+// lookupPath walks a path such as "user.addresses[0].city" through decoded JSON.
+func lookupPath(data interface{}, path string) (interface{}, bool) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	path = strings.NewReplacer("[", ".", "]", "").Replace(path)
+
+	for _, segment := range strings.Split(path, ".") {
+		if segment == "" {
+			continue
+		}
+		switch v := data.(type) {
+		case map[string]interface{}:
+			value, ok := v[segment]
+			if !ok {
+				return nil, false
+			}
+			data = value
+		case []interface{}:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			data = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return data, true
+}
+
+func toComparableString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		out, _ := json.Marshal(v)
+		return string(out)
+	}
+}
+
+// projectMessageFields renders the given fields of a message as a JSON object, in order.
+func projectMessageFields(fields *messageFields, names []string) (string, error) {
+	buf := new(bytes.Buffer)
+	buf.WriteString("{")
+	for i, name := range names {
+		value, _, err := fields.get(name)
+		if err != nil {
+			return "", err
+		}
+
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		out, err := json.Marshal(name)
+		if err != nil {
+			return "", err
+		}
+		buf.Write(out)
+		buf.WriteString(":")
+		out, err = json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		buf.Write(out)
+	}
+	buf.WriteString("}")
+	return buf.String(), nil
+}
@@ -0,0 +1,80 @@
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/internal/pkg/serdes"
+)
+
+func newTestMessageFields() *messageFields {
+	topic := "my-topic"
+	msg := &ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{Topic: &topic, Partition: 2, Offset: 7},
+		Timestamp:      time.UnixMilli(1672531200000),
+		Headers:        []ckafka.Header{{Key: "source", Value: []byte("web")}},
+		Key:            []byte("my-key"),
+		Value:          []byte(`{"user":{"id":42,"name":"alice"},"tags":["a","b"]}`),
+	}
+	return newMessageFields(msg, &GroupHandler{KeyFormat: serdes.RAWSCHEMANAME, Format: serdes.RAWSCHEMANAME})
+}
+
+func TestParseMessageFilter(t *testing.T) {
+	req := require.New(t)
+
+	filter, err := parseMessageFilter(" value.user.id == 42 ")
+	req.NoError(err)
+	req.Equal("value.user.id", filter.field)
+	req.Equal("==", filter.operator)
+	req.Equal("42", filter.value)
+
+	filter, err = parseMessageFilter("key=~^my-.*$")
+	req.NoError(err)
+	req.Equal("=~", filter.operator)
+	req.NotNil(filter.regex)
+
+	_, err = parseMessageFilter("key")
+	req.EqualError(err, `invalid filter "key": must be formatted as "<field><operator><value>", where the operator is one of "==", "!=", "=~", or "!~"`)
+
+	_, err = parseMessageFilter("body==1")
+	req.Error(err)
+}
+
+func TestMessageFilterMatches(t *testing.T) {
+	req := require.New(t)
+
+	tests := map[string]bool{
+		"key==my-key":           true,
+		"key!=my-key":           false,
+		"partition==2":          true,
+		"offset==8":             false,
+		"header.source==web":    true,
+		"header.missing==web":   false,
+		"header.missing!=web":   true,
+		"value.user.id==42":     true,
+		"value.user.name=~^al":  true,
+		"value.user.name!~^al":  false,
+		"value.tags[1]==b":      true,
+		"value.$.tags[0]==a":    true,
+		"value.user.missing==1": false,
+	}
+
+	for expression, expected := range tests {
+		filter, err := parseMessageFilter(expression)
+		req.NoError(err)
+		matches, err := filter.matches(newTestMessageFields())
+		req.NoError(err)
+		req.Equal(expected, matches, expression)
+	}
+}
+
+func TestProjectMessageFields(t *testing.T) {
+	req := require.New(t)
+
+	projection, err := projectMessageFields(newTestMessageFields(), []string{"offset", "key", "value.user", "value.missing", "timestamp"})
+	req.NoError(err)
+	req.Equal(`{"offset":7,"key":"my-key","value.user":{"id":42,"name":"alice"},"value.missing":null,"timestamp":1672531200000}`, projection)
+}
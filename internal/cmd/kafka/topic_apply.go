@@ -0,0 +1,314 @@
+package kafka
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-yaml/yaml"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/form"
+	"github.com/confluentinc/cli/internal/pkg/output"
+	"github.com/confluentinc/cli/internal/pkg/resource"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+)
+
+const (
+	createTopicAction = "create"
+	updateTopicAction = "update"
+	deleteTopicAction = "delete"
+)
+
+const topicApplyLongDescription = "Create and update Kafka topics to match a YAML or JSON file. " +
+	"The file has a list of `topics`, each with a `name`, and optionally `partitions`, `replication_factor`, and a map of `configs`.\n\n" +
+	"The changes are printed before they are applied. " +
+	"Topics in the file which do not exist are created, and the configs in the file are updated for those which do. " +
+	"Configs which are not in the file are left unchanged, and the number of partitions and the replication factor of existing topics cannot be changed. " +
+	"If `--prune` is set, topics which are not in the file are deleted."
+
+// topicManifest is the desired state of the topics of a cluster, read from a YAML or JSON file.
+type topicManifest struct {
+	Topics []*topicSpec `yaml:"topics"`
+}
+
+type topicSpec struct {
+	Name              string            `yaml:"name"`
+	Partitions        int32             `yaml:"partitions"`
+	ReplicationFactor int32             `yaml:"replication_factor"`
+	Configs           map[string]string `yaml:"configs"`
+}
+
+// topicState is the current state of a topic in a cluster.
+type topicState struct {
+	partitions        int32
+	replicationFactor int32
+	isInternal        bool
+}
+
+type topicConfig struct {
+	value     string
+	isDefault bool
+}
+
+// topicLister abstracts the Kafka REST clients of Confluent Cloud and Confluent Platform.
+type topicLister interface {
+	listTopics() (map[string]*topicState, error)
+	listTopicConfigs(topic string) (map[string]*topicConfig, error)
+}
+
+type topicApplyClient interface {
+	topicLister
+	createTopic(spec *topicSpec) error
+	updateTopicConfigs(topic string, configs map[string]string) error
+	deleteTopic(topic string) error
+}
+
+type topicChange struct {
+	action  string
+	topic   string
+	spec    *topicSpec
+	configs map[string]string
+	current map[string]*topicConfig
+}
+
+type topicPlanOut struct {
+	Topic   string `human:"Topic" serialized:"topic"`
+	Action  string `human:"Action" serialized:"action"`
+	Setting string `human:"Setting,omitempty" serialized:"setting,omitempty"`
+	Current string `human:"Current Value,omitempty" serialized:"current,omitempty"`
+	Desired string `human:"Desired Value,omitempty" serialized:"desired,omitempty"`
+}
+
+func addTopicApplyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("file", "f", "", "The path to a YAML or JSON file of topics.")
+	cmd.Flags().Bool("prune", false, "Delete topics which are not in the file, except for internal topics and topics starting with an underscore.")
+	cmd.Flags().Bool("dry-run", false, "Print the changes without applying them.")
+	pcmd.AddForceFlag(cmd)
+
+	_ = cmd.MarkFlagRequired("file")
+}
+
+func runTopicApply(cmd *cobra.Command, client topicApplyClient) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	prune, err := cmd.Flags().GetBool("prune")
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	manifest, err := readTopicManifest(file)
+	if err != nil {
+		return err
+	}
+
+	changes, err := planTopicChanges(manifest, client, prune)
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		utils.ErrPrint(cmd, errors.TopicsUpToDateMsg)
+		return nil
+	}
+
+	list := output.NewList(cmd)
+	for _, change := range changes {
+		for _, row := range change.rows() {
+			list.Add(row)
+		}
+	}
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	deletions := 0
+	for _, change := range changes {
+		if change.action == deleteTopicAction {
+			deletions++
+		}
+	}
+	if deletions > 0 {
+		if ok, err := form.ConfirmDeletion(cmd, fmt.Sprintf(errors.PruneTopicsConfirmMsg, deletions), ""); err != nil || !ok {
+			return err
+		}
+	}
+
+	for _, change := range changes {
+		switch change.action {
+		case createTopicAction:
+			if err := client.createTopic(change.spec); err != nil {
+				return err
+			}
+			utils.ErrPrintf(cmd, errors.CreatedResourceMsg, resource.Topic, change.topic)
+		case updateTopicAction:
+			if err := client.updateTopicConfigs(change.topic, change.configs); err != nil {
+				return err
+			}
+			utils.ErrPrintf(cmd, errors.UpdatedResourceMsg, resource.Topic, change.topic)
+		case deleteTopicAction:
+			if err := client.deleteTopic(change.topic); err != nil {
+				return err
+			}
+			utils.ErrPrintf(cmd, errors.DeletedResourceMsg, resource.Topic, change.topic)
+		}
+	}
+
+	return nil
+}
+
+func readTopicManifest(file string) (*topicManifest, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is a subset of YAML, so both formats are read the same way.
+	manifest := new(topicManifest)
+	if err := yaml.UnmarshalStrict(data, manifest); err != nil {
+		return nil, errors.Errorf(errors.InvalidTopicManifestErrorMsg, file, err)
+	}
+
+	names := map[string]bool{}
+	for i, spec := range manifest.Topics {
+		if spec == nil || spec.Name == "" {
+			return nil, errors.Errorf(errors.InvalidTopicManifestErrorMsg, file, fmt.Sprintf("topic %d has no name", i+1))
+		}
+		if names[spec.Name] {
+			return nil, errors.Errorf(errors.InvalidTopicManifestErrorMsg, file, fmt.Sprintf(`topic "%s" is defined more than once`, spec.Name))
+		}
+		names[spec.Name] = true
+
+		if spec.Partitions < 0 || spec.ReplicationFactor < 0 {
+			return nil, errors.Errorf(errors.InvalidTopicManifestErrorMsg, file, fmt.Sprintf(`topic "%s" cannot have a negative number of partitions or replicas`, spec.Name))
+		}
+	}
+
+	return manifest, nil
+}
+
+// planTopicChanges compares a manifest to the current topics of a cluster, and returns the changes needed to converge them.
+// Only the configs in the manifest are compared; other configs of existing topics are left as is.
+func planTopicChanges(manifest *topicManifest, client topicApplyClient, prune bool) ([]*topicChange, error) {
+	topics, err := client.listTopics()
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*topicChange
+	for _, spec := range manifest.Topics {
+		state, ok := topics[spec.Name]
+		if !ok {
+			changes = append(changes, &topicChange{action: createTopicAction, topic: spec.Name, spec: spec})
+			continue
+		}
+
+		if spec.Partitions != 0 && spec.Partitions != state.partitions {
+			return nil, errors.NewErrorWithSuggestions(fmt.Sprintf(errors.ChangeTopicPartitionsErrorMsg, spec.Name, state.partitions, spec.Partitions), errors.ChangeTopicPartitionsSuggestions)
+		}
+		if spec.ReplicationFactor != 0 && spec.ReplicationFactor != state.replicationFactor {
+			return nil, errors.Errorf(errors.ChangeTopicReplicationFactorErrorMsg, spec.Name, state.replicationFactor, spec.ReplicationFactor)
+		}
+
+		if len(spec.Configs) == 0 {
+			continue
+		}
+
+		current, err := client.listTopicConfigs(spec.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		configs := map[string]string{}
+		for name, value := range spec.Configs {
+			if config, ok := current[name]; !ok || config.value != value {
+				configs[name] = value
+			}
+		}
+		if len(configs) > 0 {
+			changes = append(changes, &topicChange{action: updateTopicAction, topic: spec.Name, spec: spec, configs: configs, current: current})
+		}
+	}
+
+	if prune {
+		desired := map[string]bool{}
+		for _, spec := range manifest.Topics {
+			desired[spec.Name] = true
+		}
+
+		var deleted []string
+		for name, state := range topics {
+			if !desired[name] && !state.isInternal && !strings.HasPrefix(name, "_") {
+				deleted = append(deleted, name)
+			}
+		}
+		sort.Strings(deleted)
+
+		for _, name := range deleted {
+			changes = append(changes, &topicChange{action: deleteTopicAction, topic: name})
+		}
+	}
+
+	return changes, nil
+}
+
+// rows returns the plan of a change, with one row for each setting it changes.
+func (c *topicChange) rows() []*topicPlanOut {
+	switch c.action {
+	case createTopicAction:
+		var rows []*topicPlanOut
+		if c.spec.Partitions != 0 {
+			rows = append(rows, &topicPlanOut{Topic: c.topic, Action: c.action, Setting: "partitions", Desired: strconv.Itoa(int(c.spec.Partitions))})
+		}
+		if c.spec.ReplicationFactor != 0 {
+			rows = append(rows, &topicPlanOut{Topic: c.topic, Action: c.action, Setting: "replication_factor", Desired: strconv.Itoa(int(c.spec.ReplicationFactor))})
+		}
+		for _, name := range sortedKeys(c.spec.Configs) {
+			rows = append(rows, &topicPlanOut{Topic: c.topic, Action: c.action, Setting: name, Desired: c.spec.Configs[name]})
+		}
+		if len(rows) == 0 {
+			rows = append(rows, &topicPlanOut{Topic: c.topic, Action: c.action})
+		}
+		return rows
+	case updateTopicAction:
+		rows := make([]*topicPlanOut, 0, len(c.configs))
+		for _, name := range sortedKeys(c.configs) {
+			rows = append(rows, &topicPlanOut{Topic: c.topic, Action: c.action, Setting: name, Current: getConfigValue(c.current, name), Desired: c.configs[name]})
+		}
+		return rows
+	default:
+		return []*topicPlanOut{{Topic: c.topic, Action: c.action}}
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func getConfigValue(configs map[string]*topicConfig, name string) string {
+	if config, ok := configs[name]; ok {
+		return config.value
+	}
+	return ""
+}
@@ -0,0 +1,142 @@
+package kafka
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+)
+
+type fakeTopicApplyClient struct {
+	topics  map[string]*topicState
+	configs map[string]map[string]*topicConfig
+}
+
+func (c *fakeTopicApplyClient) listTopics() (map[string]*topicState, error) {
+	return c.topics, nil
+}
+
+func (c *fakeTopicApplyClient) listTopicConfigs(topic string) (map[string]*topicConfig, error) {
+	return c.configs[topic], nil
+}
+
+func (c *fakeTopicApplyClient) createTopic(_ *topicSpec) error {
+	return nil
+}
+
+func (c *fakeTopicApplyClient) updateTopicConfigs(_ string, _ map[string]string) error {
+	return nil
+}
+
+func (c *fakeTopicApplyClient) deleteTopic(_ string) error {
+	return nil
+}
+
+func TestReadTopicManifest(t *testing.T) {
+	req := require.New(t)
+
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "topics.yaml")
+	req.NoError(os.WriteFile(file, []byte("topics:\n  - name: orders\n    partitions: 6\n    configs:\n      retention.ms: 259200000\n"), 0644))
+	manifest, err := readTopicManifest(file)
+	req.NoError(err)
+	req.Equal([]*topicSpec{{Name: "orders", Partitions: 6, Configs: map[string]string{"retention.ms": "259200000"}}}, manifest.Topics)
+
+	file = filepath.Join(dir, "topics.json")
+	req.NoError(os.WriteFile(file, []byte(`{"topics": [{"name": "orders", "replication_factor": 3}]}`), 0644))
+	manifest, err = readTopicManifest(file)
+	req.NoError(err)
+	req.Equal([]*topicSpec{{Name: "orders", ReplicationFactor: 3}}, manifest.Topics)
+
+	req.NoError(os.WriteFile(file, []byte(`{"topics": [{"name": "orders"}, {"name": "orders"}]}`), 0644))
+	_, err = readTopicManifest(file)
+	req.EqualError(err, `invalid topic manifest "`+file+`": topic "orders" is defined more than once`)
+
+	req.NoError(os.WriteFile(file, []byte(`{"topics": [{"partitions": 1}]}`), 0644))
+	_, err = readTopicManifest(file)
+	req.EqualError(err, `invalid topic manifest "`+file+`": topic 1 has no name`)
+
+	req.NoError(os.WriteFile(file, []byte(`{"topics": [{"name": "orders", "partition": 1}]}`), 0644))
+	_, err = readTopicManifest(file)
+	req.Error(err)
+}
+
+func TestPlanTopicChanges(t *testing.T) {
+	req := require.New(t)
+
+	client := &fakeTopicApplyClient{
+		topics: map[string]*topicState{
+			"orders":    {partitions: 6, replicationFactor: 3},
+			"payments":  {partitions: 1, replicationFactor: 3},
+			"legacy":    {partitions: 1, replicationFactor: 3},
+			"_internal": {partitions: 1, replicationFactor: 3},
+		},
+		configs: map[string]map[string]*topicConfig{
+			"orders":   {"cleanup.policy": {value: "delete", isDefault: true}, "retention.ms": {value: "604800000", isDefault: true}},
+			"payments": {"cleanup.policy": {value: "compact"}},
+		},
+	}
+
+	manifest := &topicManifest{Topics: []*topicSpec{
+		{Name: "orders", Partitions: 6, Configs: map[string]string{"cleanup.policy": "delete", "retention.ms": "259200000"}},
+		{Name: "payments", Configs: map[string]string{"cleanup.policy": "compact"}},
+		{Name: "refunds", Partitions: 3},
+	}}
+
+	changes, err := planTopicChanges(manifest, client, false)
+	req.NoError(err)
+	req.Len(changes, 2)
+	req.Equal(updateTopicAction, changes[0].action)
+	req.Equal(map[string]string{"retention.ms": "259200000"}, changes[0].configs)
+	req.Equal([]*topicPlanOut{{Topic: "orders", Action: updateTopicAction, Setting: "retention.ms", Current: "604800000", Desired: "259200000"}}, changes[0].rows())
+	req.Equal(createTopicAction, changes[1].action)
+	req.Equal([]*topicPlanOut{{Topic: "refunds", Action: createTopicAction, Setting: "partitions", Desired: "3"}}, changes[1].rows())
+
+	changes, err = planTopicChanges(manifest, client, true)
+	req.NoError(err)
+	req.Len(changes, 3)
+	req.Equal(&topicChange{action: deleteTopicAction, topic: "legacy"}, changes[2])
+
+	manifest.Topics[0].Partitions = 12
+	_, err = planTopicChanges(manifest, client, false)
+	req.EqualError(err, `cannot change the number of partitions of topic "orders" from 6 to 12`)
+}
+
+func TestRunTopicApply_DryRun(t *testing.T) {
+	req := require.New(t)
+
+	file := filepath.Join(t.TempDir(), "topics.yaml")
+	req.NoError(os.WriteFile(file, []byte("topics:\n  - name: orders\n    configs:\n      retention.ms: 259200000\n      min.insync.replicas: 2\n      cleanup.policy: delete\n"), 0644))
+
+	client := &fakeTopicApplyClient{
+		topics: map[string]*topicState{"orders": {partitions: 6, replicationFactor: 3}},
+		configs: map[string]map[string]*topicConfig{
+			"orders": {"cleanup.policy": {value: "delete", isDefault: true}, "retention.ms": {value: "604800000", isDefault: true}},
+		},
+	}
+
+	cmd := &cobra.Command{}
+	addTopicApplyFlags(cmd)
+	pcmd.AddOutputFlag(cmd)
+	req.NoError(cmd.Flags().Set("file", file))
+	req.NoError(cmd.Flags().Set("dry-run", "true"))
+	req.NoError(cmd.Flags().Set("output", "json"))
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+
+	req.NoError(runTopicApply(cmd, client))
+
+	var rows []map[string]string
+	req.NoError(json.Unmarshal(buf.Bytes(), &rows))
+	req.Equal([]map[string]string{
+		{"topic": "orders", "action": updateTopicAction, "setting": "min.insync.replicas", "desired": "2"},
+		{"topic": "orders", "action": updateTopicAction, "setting": "retention.ms", "current": "604800000", "desired": "259200000"},
+	}, rows)
+}
@@ -0,0 +1,297 @@
+package kafka
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	sr "github.com/confluentinc/cli/internal/cmd/schema-registry"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+)
+
+// archiveEntry is a line of a topic archive, which holds either a schema or a message.
+// Schemas are written before the first message that references them.
+type archiveEntry struct {
+	Schema  *archiveSchema  `json:"schema,omitempty"`
+	Message *archiveMessage `json:"message,omitempty"`
+}
+
+// archiveSchema holds a schema without references, since referenced schemas cannot be registered
+// under the subjects of the destination topic.
+type archiveSchema struct {
+	Id         int32  `json:"id"`
+	SchemaType string `json:"schema_type,omitempty"`
+	Schema     string `json:"schema"`
+}
+
+// archiveMessage holds the raw bytes of a message, which are encoded as base64.
+type archiveMessage struct {
+	Partition     int32           `json:"partition"`
+	Offset        int64           `json:"offset"`
+	Timestamp     int64           `json:"timestamp"`
+	Headers       []archiveHeader `json:"headers,omitempty"`
+	Key           []byte          `json:"key"`
+	Value         []byte          `json:"value"`
+	KeySchemaId   int32           `json:"key_schema_id,omitempty"`
+	ValueSchemaId int32           `json:"value_schema_id,omitempty"`
+}
+
+type archiveHeader struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+func newArchiveMessage(msg *ckafka.Message) *archiveMessage {
+	archived := &archiveMessage{
+		Partition: msg.TopicPartition.Partition,
+		Offset:    int64(msg.TopicPartition.Offset),
+		Timestamp: msg.Timestamp.UnixMilli(),
+		Key:       msg.Key,
+		Value:     msg.Value,
+	}
+	for _, header := range msg.Headers {
+		archived.Headers = append(archived.Headers, archiveHeader{Key: header.Key, Value: header.Value})
+	}
+	return archived
+}
+
+func (m *archiveMessage) toKafkaMessage(topic string, preservePartitions bool) *ckafka.Message {
+	msg := &ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{Topic: &topic, Partition: ckafka.PartitionAny},
+		Timestamp:      time.UnixMilli(m.Timestamp),
+		Key:            m.Key,
+		Value:          m.Value,
+	}
+	if preservePartitions {
+		msg.TopicPartition.Partition = m.Partition
+	}
+	for _, header := range m.Headers {
+		msg.Headers = append(msg.Headers, ckafka.Header{Key: header.Key, Value: header.Value})
+	}
+	return msg
+}
+
+// getSchemaId returns the schema ID stored in the meta info of a serialized key or value, if it has one.
+func getSchemaId(data []byte) (int32, bool) {
+	if len(data) < messageOffset || data[0] != 0x0 {
+		return 0, false
+	}
+	return int32(binary.BigEndian.Uint32(data[1:messageOffset])), true
+}
+
+// setSchemaId returns a copy of a serialized key or value with its schema ID replaced.
+func setSchemaId(data []byte, id int32) []byte {
+	return append(sr.GetMetaInfoFromSchemaId(id), data[messageOffset:]...)
+}
+
+// topicExporter writes consumed messages to a topic archive, along with the schemas they reference
+// when a Schema Registry client is set.
+type topicExporter struct {
+	encoder  *json.Encoder
+	topic    string
+	srClient *srsdk.APIClient
+	ctx      context.Context
+
+	schemaIds map[int32]bool
+	exported  int
+}
+
+func newTopicExporter(out io.Writer, topic string, srClient *srsdk.APIClient, ctx context.Context) *topicExporter {
+	return &topicExporter{
+		encoder:   json.NewEncoder(out),
+		topic:     topic,
+		srClient:  srClient,
+		ctx:       ctx,
+		schemaIds: map[int32]bool{},
+	}
+}
+
+func (e *topicExporter) export(msg *ckafka.Message) error {
+	archived := newArchiveMessage(msg)
+
+	if e.srClient != nil {
+		if id, ok := getSchemaId(msg.Key); ok {
+			if err := e.exportSchema(id, keyMode); err != nil {
+				return err
+			}
+			archived.KeySchemaId = id
+		}
+		if id, ok := getSchemaId(msg.Value); ok {
+			if err := e.exportSchema(id, valueMode); err != nil {
+				return err
+			}
+			archived.ValueSchemaId = id
+		}
+	}
+
+	if err := e.encoder.Encode(&archiveEntry{Message: archived}); err != nil {
+		return err
+	}
+	e.exported++
+	return nil
+}
+
+func (e *topicExporter) exportSchema(id int32, mode string) error {
+	if e.schemaIds[id] {
+		return nil
+	}
+
+	schema, err := requestArchiveSchema(id, topicNameStrategy(e.topic, mode), e.srClient, e.ctx)
+	if err != nil {
+		return err
+	}
+	if err := e.encoder.Encode(&archiveEntry{Schema: schema}); err != nil {
+		return err
+	}
+	e.schemaIds[id] = true
+	return nil
+}
+
+// requestArchiveSchema fetches a schema referenced by the messages of a topic from its Schema Registry cluster.
+// Schemas with references are refused, since their references would bind to whichever schemas happen to be registered
+// under the same subjects and versions in the destination Schema Registry cluster.
+func requestArchiveSchema(id int32, subject string, srClient *srsdk.APIClient, ctx context.Context) (*archiveSchema, error) {
+	schemaString, err := sr.RequestSchemaWithId(id, subject, srClient, ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.FailedToExportSchemaErrorMsg, id)
+	}
+	if len(schemaString.References) > 0 {
+		return nil, errors.NewErrorWithSuggestions(fmt.Sprintf(errors.ExportSchemaReferencesErrorMsg, id), errors.ExportSchemaReferencesSuggestions)
+	}
+
+	return &archiveSchema{
+		Id:         id,
+		SchemaType: schemaString.SchemaType,
+		Schema:     schemaString.Schema,
+	}, nil
+}
+
+// topicImporter reads messages from a topic archive. When a Schema Registry client is set, the schema IDs
+// of messages are remapped to those of the archived schemas, registered under the subjects of the destination topic.
+// The archive is decoded as a stream of JSON values rather than scanned line by line, since a line holding
+// a large message encoded as base64 can exceed the size of any line buffer.
+type topicImporter struct {
+	decoder            *json.Decoder
+	topic              string
+	preservePartitions bool
+	remapper           *schemaRemapper
+
+	entries int
+	schemas map[int32]*archiveSchema
+}
+
+func newTopicImporter(in io.Reader, topic string, preservePartitions bool, srClient *srsdk.APIClient, ctx context.Context) *topicImporter {
+	i := &topicImporter{
+		decoder:            json.NewDecoder(in),
+		topic:              topic,
+		preservePartitions: preservePartitions,
+		schemas:            map[int32]*archiveSchema{},
+	}
+	if srClient != nil {
+		i.remapper = newSchemaRemapper(topic, srClient, ctx, func(id int32, _ string) (*archiveSchema, error) {
+			schema, ok := i.schemas[id]
+			if !ok {
+				return nil, errors.Errorf(errors.MissingArchivedSchemaErrorMsg, id)
+			}
+			return schema, nil
+		})
+	}
+	return i
+}
+
+// next returns the next message of the topic archive, or io.EOF once every entry has been read.
+func (i *topicImporter) next() (*ckafka.Message, error) {
+	for {
+		i.entries++
+		entry := new(archiveEntry)
+		if err := i.decoder.Decode(entry); err == io.EOF {
+			return nil, io.EOF
+		} else if err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidArchiveEntryErrorMsg, i.entries)
+		}
+
+		if msg, err := i.getMessage(entry); err != nil || msg != nil {
+			return msg, err
+		}
+	}
+}
+
+// getMessage returns the message of an archive entry, or no message for schema entries.
+func (i *topicImporter) getMessage(entry *archiveEntry) (*ckafka.Message, error) {
+	switch {
+	case entry.Schema != nil:
+		i.schemas[entry.Schema.Id] = entry.Schema
+		return nil, nil
+	case entry.Message != nil:
+		msg := entry.Message.toKafkaMessage(i.topic, i.preservePartitions)
+		if i.remapper == nil {
+			return msg, nil
+		}
+
+		var err error
+		if id := entry.Message.KeySchemaId; id != 0 {
+			if msg.Key, err = i.remapper.remap(msg.Key, id, keyMode); err != nil {
+				return nil, err
+			}
+		}
+		if id := entry.Message.ValueSchemaId; id != 0 {
+			if msg.Value, err = i.remapper.remap(msg.Value, id, valueMode); err != nil {
+				return nil, err
+			}
+		}
+		return msg, nil
+	default:
+		return nil, errors.Errorf(errors.InvalidArchiveEntryErrorMsg, i.entries)
+	}
+}
+
+// schemaRemapper registers schemas under the subjects of a destination topic, and replaces the schema IDs
+// of serialized keys and values with the registered schema IDs.
+type schemaRemapper struct {
+	topic     string
+	srClient  *srsdk.APIClient
+	ctx       context.Context
+	getSchema func(id int32, mode string) (*archiveSchema, error)
+
+	schemaIds map[string]map[int32]int32 // The registered schema ID of each original schema ID, by key or value mode.
+}
+
+func newSchemaRemapper(topic string, srClient *srsdk.APIClient, ctx context.Context, getSchema func(int32, string) (*archiveSchema, error)) *schemaRemapper {
+	return &schemaRemapper{
+		topic:     topic,
+		srClient:  srClient,
+		ctx:       ctx,
+		getSchema: getSchema,
+		schemaIds: map[string]map[int32]int32{keyMode: {}, valueMode: {}},
+	}
+}
+
+func (r *schemaRemapper) remap(data []byte, id int32, mode string) ([]byte, error) {
+	newId, ok := r.schemaIds[mode][id]
+	if !ok {
+		schema, err := r.getSchema(id, mode)
+		if err != nil {
+			return nil, err
+		}
+
+		subject := topicNameStrategy(r.topic, mode)
+		request := srsdk.RegisterSchemaRequest{Schema: schema.Schema, SchemaType: schema.SchemaType}
+		response, _, err := r.srClient.DefaultApi.Register(r.ctx, subject, request)
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.FailedToRegisterSchemaErrorMsg, id, subject)
+		}
+		newId = response.Id
+		r.schemaIds[mode][id] = newId
+	}
+
+	if newId == id {
+		return data, nil
+	}
+	return setSchemaId(data, newId), nil
+}
@@ -0,0 +1,175 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	srMock "github.com/confluentinc/schema-registry-sdk-go/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/internal/pkg/errors"
+)
+
+func TestTopicArchive_RoundTrip(t *testing.T) {
+	req := require.New(t)
+
+	topic := "my-topic"
+	msg := &ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{Topic: &topic, Partition: 2, Offset: 7},
+		Timestamp:      time.UnixMilli(1672531200000),
+		Headers:        []ckafka.Header{{Key: "app", Value: []byte("cli")}, {Key: "empty"}},
+		Value:          []byte{0x0, 0x0, 0x1, 0x86, 0xa1, 0x2},
+	}
+
+	out := new(bytes.Buffer)
+	exporter := newTopicExporter(out, topic, nil, nil)
+	req.NoError(exporter.export(msg))
+	req.Equal(1, exporter.exported)
+	req.Equal(`{"message":{"partition":2,"offset":7,"timestamp":1672531200000,"headers":[{"key":"app","value":"Y2xp"},{"key":"empty","value":null}],"key":null,"value":"AAABhqEC"}}`+"\n", out.String())
+
+	importer := newTopicImporter(strings.NewReader(out.String()), "other-topic", false, nil, nil)
+	imported, err := importer.next()
+	req.NoError(err)
+	req.Equal("other-topic", *imported.TopicPartition.Topic)
+	req.Equal(ckafka.PartitionAny, imported.TopicPartition.Partition)
+	req.Equal(msg.Timestamp, imported.Timestamp)
+	req.Equal(msg.Headers, imported.Headers)
+	req.Nil(imported.Key)
+	req.Equal(msg.Value, imported.Value)
+	_, err = importer.next()
+	req.Equal(io.EOF, err)
+
+	importer = newTopicImporter(strings.NewReader(out.String()), "other-topic", true, nil, nil)
+	imported, err = importer.next()
+	req.NoError(err)
+	req.Equal(int32(2), imported.TopicPartition.Partition)
+}
+
+func TestTopicArchive_RoundTripLargeMessage(t *testing.T) {
+	req := require.New(t)
+
+	// Encoded as base64, a value of 2 MB takes up more than the largest line of input accepted by `kafka topic produce`.
+	topic := "my-topic"
+	value := bytes.Repeat([]byte{0xca, 0xfe}, 1024*1024)
+	msgs := []*ckafka.Message{
+		{TopicPartition: ckafka.TopicPartition{Topic: &topic}, Key: []byte("large"), Value: value},
+		{TopicPartition: ckafka.TopicPartition{Topic: &topic, Offset: 1}, Key: []byte("small"), Value: []byte("value")},
+	}
+
+	out := new(bytes.Buffer)
+	exporter := newTopicExporter(out, topic, nil, nil)
+	for _, msg := range msgs {
+		req.NoError(exporter.export(msg))
+	}
+
+	importer := newTopicImporter(out, topic, false, nil, nil)
+	for _, msg := range msgs {
+		imported, err := importer.next()
+		req.NoError(err)
+		req.Equal(msg.Key, imported.Key)
+		req.Equal(msg.Value, imported.Value)
+	}
+	_, err := importer.next()
+	req.Equal(io.EOF, err)
+}
+
+func TestTopicImporter_Next(t *testing.T) {
+	req := require.New(t)
+
+	archive := `{"schema":{"id":100001,"schema_type":"AVRO","schema":"\"int\""}}
+{"message":{"partition":0,"offset":0,"timestamp":0,"key":null,"value":"AAABhqEC","value_schema_id":100001}}
+{"message":{"partition":0,"offset":1,"timestamp":0,"key":"AAAAAAcC","value":null,"key_schema_id":7}}
+{}
+`
+	importer := newTopicImporter(strings.NewReader(archive), "my-topic", false, &srsdk.APIClient{}, context.Background())
+	importer.remapper.schemaIds[valueMode][100001] = 5
+
+	msg, err := importer.next()
+	req.NoError(err)
+	req.Contains(importer.schemas, int32(100001))
+	req.Equal([]byte{0x0, 0x0, 0x0, 0x0, 0x5, 0x2}, msg.Value)
+
+	_, err = importer.next()
+	req.EqualError(err, "schema with ID 7 is missing from the topic archive; export the topic with `--include-schemas` to remap schema IDs")
+
+	_, err = importer.next()
+	req.EqualError(err, `invalid entry 4 in topic archive: must be a JSON object with a "schema" or "message" field`)
+
+	_, err = newTopicImporter(strings.NewReader("my-message\n"), "my-topic", false, nil, nil).next()
+	req.ErrorContains(err, "invalid entry 1 in topic archive")
+}
+
+func TestRequestArchiveSchema(t *testing.T) {
+	req := require.New(t)
+
+	srClient := &srsdk.APIClient{
+		DefaultApi: &srMock.DefaultApi{
+			GetSchemaFunc: func(_ context.Context, id int32, _ *srsdk.GetSchemaOpts) (srsdk.SchemaString, *http.Response, error) {
+				schema := srsdk.SchemaString{SchemaType: "AVRO", Schema: `"int"`}
+				if id == 100002 {
+					schema.References = []srsdk.SchemaReference{{Name: "other", Subject: "other-value", Version: 1}}
+				}
+				return schema, nil, nil
+			},
+		},
+	}
+
+	schema, err := requestArchiveSchema(100001, "my-topic-value", srClient, context.Background())
+	req.NoError(err)
+	req.Equal(&archiveSchema{Id: 100001, SchemaType: "AVRO", Schema: `"int"`}, schema)
+
+	_, err = requestArchiveSchema(100002, "my-topic-value", srClient, context.Background())
+	errors.VerifyErrorAndSuggestions(req, err, "cannot export schema with ID 100002: schemas with references are not supported", errors.ExportSchemaReferencesSuggestions)
+}
+
+func TestSchemaRemapper_Remap(t *testing.T) {
+	req := require.New(t)
+
+	api := &srMock.DefaultApi{
+		RegisterFunc: func(_ context.Context, subject string, body srsdk.RegisterSchemaRequest) (srsdk.RegisterSchemaResponse, *http.Response, error) {
+			if body.Schema != `"int"` {
+				return srsdk.RegisterSchemaResponse{}, nil, errors.New("invalid schema")
+			}
+			return srsdk.RegisterSchemaResponse{Id: 5}, nil, nil
+		},
+	}
+	schemas := map[int32]*archiveSchema{
+		100001: {Id: 100001, SchemaType: "AVRO", Schema: `"int"`},
+		100002: {Id: 100002, SchemaType: "AVRO", Schema: `"invalid"`},
+	}
+	remapper := newSchemaRemapper("my-topic", &srsdk.APIClient{DefaultApi: api}, context.Background(), func(id int32, _ string) (*archiveSchema, error) {
+		return schemas[id], nil
+	})
+
+	for i := 0; i < 2; i++ {
+		data, err := remapper.remap([]byte{0x0, 0x0, 0x1, 0x86, 0xa1, 0x2}, 100001, valueMode)
+		req.NoError(err)
+		req.Equal([]byte{0x0, 0x0, 0x0, 0x0, 0x5, 0x2}, data)
+	}
+	req.Len(api.RegisterCalls(), 1)
+	req.Equal("my-topic-value", api.RegisterCalls()[0].Subject)
+
+	_, err := remapper.remap([]byte{0x0, 0x0, 0x1, 0x86, 0xa2, 0x2}, 100002, keyMode)
+	req.EqualError(err, `failed to register schema with ID 100002 under subject "my-topic-key": invalid schema`)
+}
+
+func TestGetSchemaId(t *testing.T) {
+	req := require.New(t)
+
+	id, ok := getSchemaId([]byte{0x0, 0x0, 0x1, 0x86, 0xa1, 0x2})
+	req.True(ok)
+	req.Equal(int32(100001), id)
+
+	_, ok = getSchemaId([]byte("my-value"))
+	req.False(ok)
+
+	_, ok = getSchemaId(nil)
+	req.False(ok)
+}
@@ -0,0 +1,53 @@
+package kafka
+
+import (
+	"io"
+	"time"
+)
+
+// generatedMessageReader reads generated messages, one per line, for `runProducer`.
+// It stops after count messages, or never if count is 0, and waits between messages to produce no faster than the rate.
+type generatedMessageReader struct {
+	generate func() (string, error)
+	count    int
+	interval time.Duration
+
+	generated int
+	next      time.Time
+	buf       []byte
+}
+
+func newGeneratedMessageReader(generate func() (string, error), count int, rate float64) *generatedMessageReader {
+	r := &generatedMessageReader{generate: generate, count: count}
+	if rate > 0 {
+		r.interval = time.Duration(float64(time.Second) / rate)
+	}
+	return r
+}
+
+func (r *generatedMessageReader) Read(p []byte) (int, error) {
+	if len(r.buf) == 0 {
+		if r.count > 0 && r.generated >= r.count {
+			return 0, io.EOF
+		}
+
+		if r.interval > 0 {
+			if r.next.IsZero() {
+				r.next = time.Now()
+			}
+			time.Sleep(time.Until(r.next))
+			r.next = r.next.Add(r.interval)
+		}
+
+		message, err := r.generate()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = []byte(message + "\n")
+		r.generated++
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
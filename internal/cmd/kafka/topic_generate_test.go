@@ -0,0 +1,61 @@
+package kafka
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/stretchr/testify/require"
+
+	sr "github.com/confluentinc/cli/internal/cmd/schema-registry"
+	"github.com/confluentinc/cli/internal/pkg/serdes"
+)
+
+func TestGeneratedMessageReader(t *testing.T) {
+	req := require.New(t)
+
+	i := 0
+	generate := func() (string, error) {
+		i++
+		return fmt.Sprintf(`{"id":%d}`, i), nil
+	}
+
+	start := time.Now()
+	scanner := bufio.NewScanner(newGeneratedMessageReader(generate, 3, 50))
+	var lines []string
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	req.NoError(scanner.Err())
+	req.Equal([]string{`{"id":1}`, `{"id":2}`, `{"id":3}`}, lines)
+	req.GreaterOrEqual(time.Since(start), 40*time.Millisecond)
+}
+
+func TestGenerateAndSerialize(t *testing.T) {
+	req := require.New(t)
+
+	schema := `{"type":"record","name":"Order","fields":[{"name":"id","type":{"type":"string","logicalType":"uuid"}},{"name":"created_at","type":["null",{"type":"long","logicalType":"timestamp-millis"}]}]}`
+	schemaPath := filepath.Join(t.TempDir(), "order.avsc")
+	req.NoError(os.WriteFile(schemaPath, []byte(schema), 0644))
+
+	serializer, err := serdes.GetSerializationProvider(serdes.AVROSCHEMANAME)
+	req.NoError(err)
+	req.NoError(serializer.LoadSchema(schemaPath, map[string]string{}))
+
+	generator, err := sr.NewSchemaGenerator(nil, nil, srsdk.Schema{Id: 100001, Schema: schema}, rand.New(rand.NewSource(1)))
+	req.NoError(err)
+
+	metaInfo := sr.GetMetaInfoFromSchemaId(100001)
+	for i := 0; i < 10; i++ {
+		data, err := generator.Generate()
+		req.NoError(err)
+		_, value, err := getMsgKeyAndValue(nil, metaInfo, data, "", false, nil, serializer)
+		req.NoError(err, data)
+		req.Equal(metaInfo, value[:5])
+	}
+}
@@ -1,6 +1,7 @@
 package kafka
 
 import (
+	"fmt"
 	_nethttp "net/http"
 
 	cmkv2 "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
@@ -10,6 +11,7 @@ import (
 	"github.com/confluentinc/cli/internal/pkg/ccloudv2"
 	"github.com/confluentinc/cli/internal/pkg/ccstructs"
 	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	v1 "github.com/confluentinc/cli/internal/pkg/config/v1"
 	"github.com/confluentinc/cli/internal/pkg/errors"
 	"github.com/confluentinc/cli/internal/pkg/kafkarest"
 )
@@ -78,6 +80,30 @@ func getKafkaClusterLkcId(c *pcmd.AuthenticatedStateFlagCommand) (string, error)
 	return kafkaClusterConfig.ID, nil
 }
 
+// withApiKey returns a copy of a cluster which authenticates with the given API key, or its active API key if none is given.
+// The API key is used for the current command only, without being stored.
+func withApiKey(cluster *v1.KafkaClusterConfig, apiKey, apiSecret string) (*v1.KafkaClusterConfig, error) {
+	if apiKey == "" && apiSecret != "" {
+		return nil, errors.NewErrorWithSuggestions(errors.PassedSecretButNotKeyErrorMsg, errors.PassedSecretButNotKeySuggestions)
+	}
+
+	copied := *cluster
+	if apiKey != "" {
+		copied.APIKey = apiKey
+		if apiSecret != "" {
+			copied.APIKeys = map[string]*v1.APIKeyPair{apiKey: {Key: apiKey, Secret: apiSecret}}
+		}
+	}
+
+	if copied.APIKey == "" {
+		return nil, &errors.UnspecifiedAPIKeyError{ClusterID: copied.ID}
+	}
+	if copied.APIKeys[copied.APIKey] == nil {
+		return nil, &errors.UnconfiguredAPISecretError{APIKey: copied.APIKey, ClusterID: copied.ID}
+	}
+	return &copied, nil
+}
+
 func handleOpenApiError(httpResp *_nethttp.Response, err error, client *cpkafkarestv3.APIClient) error {
 	if err == nil {
 		return nil
@@ -190,6 +216,6 @@ func getCmkClusterStatus(cluster *cmkv2.CmkV2Cluster) string {
 	return cluster.Status.Phase
 }
 
-func topicNameStrategy(topic string) string {
-	return topic + "-value"
+func topicNameStrategy(topic, mode string) string {
+	return fmt.Sprintf("%s-%s", topic, mode)
 }
@@ -21,22 +21,30 @@ func New(cfg *v1.Config, prerunner pcmd.PreRunner, srClient *srsdk.APIClient) *c
 	c.AddCommand(newClusterCommand(cfg, prerunner, srClient))
 	c.AddCommand(newCompatibilityCommand(cfg, prerunner, srClient))
 	c.AddCommand(newConfigCommand(cfg, prerunner, srClient))
+	c.AddCommand(newContextCommand(cfg, prerunner, srClient))
+	c.AddCommand(newExportCommand(cfg, prerunner, srClient))
 	c.AddCommand(newExporterCommand(cfg, prerunner, srClient))
+	c.AddCommand(newImportCommand(cfg, prerunner, srClient))
+	c.AddCommand(newModeCommand(cfg, prerunner, srClient))
 	c.AddCommand(newSchemaCommand(cfg, prerunner, srClient))
 	c.AddCommand(newSubjectCommand(cfg, prerunner, srClient))
 	return c.Command
 }
 
+var compatibilityLevels = []string{"BACKWARD", "BACKWARD_TRANSITIVE", "FORWARD", "FORWARD_TRANSITIVE", "FULL", "FULL_TRANSITIVE", "NONE"}
+
 func addCompatibilityFlag(cmd *cobra.Command) {
 	cmd.Flags().String("compatibility", "", "Can be BACKWARD, BACKWARD_TRANSITIVE, FORWARD, FORWARD_TRANSITIVE, FULL, FULL_TRANSITIVE, or NONE.")
 	pcmd.RegisterFlagCompletionFunc(cmd, "compatibility", func(_ *cobra.Command, _ []string) []string {
-		return []string{"BACKWARD", "BACKWARD_TRANSITIVE", "FORWARD", "FORWARD_TRANSITIVE", "FULL", "FULL_TRANSITIVE", "NONE"}
+		return compatibilityLevels
 	})
 }
 
+var modes = []string{"READWRITE", "READONLY", "IMPORT"}
+
 func addModeFlag(cmd *cobra.Command) {
 	cmd.Flags().String("mode", "", "Can be READWRITE, READONLY, OR IMPORT.")
 	pcmd.RegisterFlagCompletionFunc(cmd, "mode", func(_ *cobra.Command, _ []string) []string {
-		return []string{"READWRITE", "READONLY", "IMPORT"}
+		return modes
 	})
 }
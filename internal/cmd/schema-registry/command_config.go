@@ -1,11 +1,18 @@
 package schemaregistry
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
 	srsdk "github.com/confluentinc/schema-registry-sdk-go"
 	"github.com/spf13/cobra"
 
 	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
 	v1 "github.com/confluentinc/cli/internal/pkg/config/v1"
+	"github.com/confluentinc/cli/internal/pkg/errors"
 )
 
 type configCommand struct {
@@ -24,11 +31,87 @@ func newConfigCommand(cfg *v1.Config, prerunner pcmd.PreRunner, srClient *srsdk.
 
 	if cfg.IsCloudLogin() {
 		c.AuthenticatedStateFlagCommand = pcmd.NewAuthenticatedStateFlagCommand(cmd, prerunner)
+		c.AddCommand(c.newDeleteCommand())
 		c.AddCommand(c.newDescribeCommand())
+		c.AddCommand(c.newUpdateCommand())
 	} else {
 		c.AuthenticatedStateFlagCommand = pcmd.NewAuthenticatedWithMDSStateFlagCommand(cmd, prerunner)
+		c.AddCommand(c.newDeleteCommandOnPrem())
 		c.AddCommand(c.newDescribeCommandOnPrem())
+		c.AddCommand(c.newUpdateCommandOnPrem())
 	}
 
 	return cmd
 }
+
+// configUpdateRequest is the body of a request to update the top-level or subject-level configuration.
+// The SDK only supports compatibility, so these requests are sent with doConfigRequest.
+type configUpdateRequest struct {
+	Compatibility      string `json:"compatibility,omitempty"`
+	Normalize          *bool  `json:"normalize,omitempty"`
+	CompatibilityGroup string `json:"compatibilityGroup,omitempty"`
+}
+
+// doConfigRequest sends a request to Schema Registry with the endpoint, HTTP client, and credentials of the SDK client,
+// and decodes the response into out.
+func doConfigRequest(srClient *srsdk.APIClient, ctx context.Context, method, path string, body, out interface{}) (*http.Response, error) {
+	cfg := srClient.GetConfig()
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, cfg.BasePath+path, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range cfg.DefaultHeader {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Accept", "application/vnd.schemaregistry.v1+json")
+	req.Header.Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	req.Header.Set("User-Agent", cfg.UserAgent)
+	if auth, ok := ctx.Value(srsdk.ContextBasicAuth).(srsdk.BasicAuth); ok {
+		req.SetBasicAuth(auth.UserName, auth.Password)
+	}
+	if token, ok := ctx.Value(srsdk.ContextAccessToken).(string); ok {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return res, err
+	}
+
+	if res.StatusCode >= http.StatusMultipleChoices {
+		var srErr struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(data, &srErr); err == nil && srErr.Message != "" {
+			return res, errors.Errorf("%s: %s", res.Status, srErr.Message)
+		}
+		return res, errors.New(res.Status)
+	}
+
+	if out == nil {
+		return res, nil
+	}
+	return res, json.Unmarshal(data, out)
+}
@@ -0,0 +1,76 @@
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/form"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+const subjectLevelConfigResource = "subject-level configuration"
+
+func (c *configCommand) newDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete subject-level configuration.",
+		Long:  "Delete the configuration of a subject, so that it uses the top-level configuration.",
+		Args:  cobra.NoArgs,
+		RunE:  c.delete,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Revert subject "payments" to the top-level configuration.`,
+				Code: fmt.Sprintf("%s schema-registry config delete --subject payments", pversion.CLIName),
+			},
+		),
+	}
+
+	cmd.Flags().String("subject", "", SubjectUsage)
+	pcmd.AddForceFlag(cmd)
+	pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddApiSecretFlag(cmd)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+
+	_ = cmd.MarkFlagRequired("subject")
+
+	return cmd
+}
+
+func (c *configCommand) delete(cmd *cobra.Command, _ []string) error {
+	srClient, ctx, err := getApiClient(cmd, c.srClient, c.Config, c.Version)
+	if err != nil {
+		return err
+	}
+
+	return deleteSchemaConfig(cmd, srClient, ctx)
+}
+
+func deleteSchemaConfig(cmd *cobra.Command, srClient *srsdk.APIClient, ctx context.Context) error {
+	subject, err := cmd.Flags().GetString("subject")
+	if err != nil {
+		return err
+	}
+
+	promptMsg := fmt.Sprintf(errors.DeleteResourceConfirmMsg, subjectLevelConfigResource, subject, subject)
+	if ok, err := form.ConfirmDeletion(cmd, promptMsg, subject); err != nil || !ok {
+		return err
+	}
+
+	// The SDK cannot decode the deleted configuration which Schema Registry returns.
+	if httpResp, err := doConfigRequest(srClient, ctx, http.MethodDelete, "/config/"+url.PathEscape(subject), nil, nil); err != nil {
+		return errors.CatchNoSubjectLevelConfigError(err, httpResp, subject)
+	}
+
+	utils.Printf(cmd, errors.DeletedResourceMsg, subjectLevelConfigResource, subject)
+	return nil
+}
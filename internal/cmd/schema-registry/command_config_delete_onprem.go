@@ -0,0 +1,46 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+func (c *configCommand) newDeleteCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "delete",
+		Short:       "Delete subject-level configuration.",
+		Long:        "Delete the configuration of a subject, so that it uses the top-level configuration.",
+		Args:        cobra.NoArgs,
+		RunE:        c.onPremDelete,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Revert subject "payments" to the top-level configuration.`,
+				Code: fmt.Sprintf("%s schema-registry config delete --subject payments %s", pversion.CLIName, OnPremAuthenticationMsg),
+			},
+		),
+	}
+
+	cmd.Flags().String("subject", "", SubjectUsage)
+	pcmd.AddForceFlag(cmd)
+	cmd.Flags().AddFlagSet(pcmd.OnPremSchemaRegistrySet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+
+	_ = cmd.MarkFlagRequired("subject")
+
+	return cmd
+}
+
+func (c *configCommand) onPremDelete(cmd *cobra.Command, _ []string) error {
+	srClient, ctx, err := GetSrApiClientWithToken(cmd, c.Version, c.AuthToken())
+	if err != nil {
+		return err
+	}
+
+	return deleteSchemaConfig(cmd, srClient, ctx)
+}
@@ -0,0 +1,52 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoConfigRequest(t *testing.T) {
+	req := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		req.True(ok)
+		req.Equal("key", username)
+		req.Equal("secret", password)
+
+		switch r.URL.Path {
+		case "/config/payments":
+			req.Equal(http.MethodPut, r.Method)
+			var body map[string]interface{}
+			req.NoError(json.NewDecoder(r.Body).Decode(&body))
+			req.Equal(map[string]interface{}{"normalize": false, "compatibilityGroup": "application.major.version"}, body)
+			req.NoError(json.NewEncoder(w).Encode(body))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`{"error_code":40401,"message":"Subject 'orders' not found."}`))
+			req.NoError(err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := srsdk.NewConfiguration()
+	cfg.BasePath = server.URL
+	srClient := srsdk.NewAPIClient(cfg)
+	ctx := context.WithValue(context.Background(), srsdk.ContextBasicAuth, srsdk.BasicAuth{UserName: "key", Password: "secret"})
+
+	normalize := false
+	out := new(configUpdateRequest)
+	_, err := doConfigRequest(srClient, ctx, http.MethodPut, "/config/payments", &configUpdateRequest{Normalize: &normalize, CompatibilityGroup: "application.major.version"}, out)
+	req.NoError(err)
+	req.Equal(&configUpdateRequest{Normalize: &normalize, CompatibilityGroup: "application.major.version"}, out)
+
+	httpResp, err := doConfigRequest(srClient, ctx, http.MethodDelete, "/config/orders", nil, nil)
+	req.EqualError(err, "404 Not Found: Subject 'orders' not found.")
+	req.Equal(http.StatusNotFound, httpResp.StatusCode)
+}
@@ -0,0 +1,122 @@
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+func (c *configCommand) newUpdateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update top-level or subject-level configuration.",
+		Long:  "Update the compatibility, normalization, or compatibility group of Schema Registry, or of a subject.",
+		Args:  cobra.NoArgs,
+		RunE:  c.update,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Update the top-level compatibility to BACKWARD_TRANSITIVE.",
+				Code: fmt.Sprintf("%s schema-registry config update --compatibility BACKWARD_TRANSITIVE", pversion.CLIName),
+			},
+			examples.Example{
+				Text: `Normalize the schemas of subject "payments", and check compatibility only against versions with the same "application.major.version".`,
+				Code: fmt.Sprintf("%s schema-registry config update --subject payments --normalize --compatibility-group application.major.version", pversion.CLIName),
+			},
+		),
+	}
+
+	addConfigUpdateFlags(cmd)
+	pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddApiSecretFlag(cmd)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+
+	return cmd
+}
+
+func addConfigUpdateFlags(cmd *cobra.Command) {
+	cmd.Flags().String("subject", "", SubjectUsage)
+	addCompatibilityFlag(cmd)
+	cmd.Flags().Bool("normalize", false, "Normalize schemas before checking compatibility and registering them.")
+	cmd.Flags().String("compatibility-group", "", "Only check compatibility against schema versions with the same value of this metadata property.")
+}
+
+func (c *configCommand) update(cmd *cobra.Command, _ []string) error {
+	srClient, ctx, err := getApiClient(cmd, c.srClient, c.Config, c.Version)
+	if err != nil {
+		return err
+	}
+
+	return updateSchemaConfig(cmd, srClient, ctx)
+}
+
+func updateSchemaConfig(cmd *cobra.Command, srClient *srsdk.APIClient, ctx context.Context) error {
+	req, err := getConfigUpdateRequest(cmd)
+	if err != nil {
+		return err
+	}
+
+	subject, err := cmd.Flags().GetString("subject")
+	if err != nil {
+		return err
+	}
+
+	if subject != "" {
+		if httpResp, err := doConfigRequest(srClient, ctx, http.MethodPut, "/config/"+url.PathEscape(subject), req, nil); err != nil {
+			return errors.CatchSchemaNotFoundError(err, httpResp)
+		}
+		utils.Printf(cmd, errors.UpdatedSubjectLevelConfigMsg, subject)
+		return nil
+	}
+
+	if _, err := doConfigRequest(srClient, ctx, http.MethodPut, "/config", req, nil); err != nil {
+		return err
+	}
+	utils.Print(cmd, errors.UpdatedTopLevelConfigMsg)
+	return nil
+}
+
+func getConfigUpdateRequest(cmd *cobra.Command) (*configUpdateRequest, error) {
+	if !cmd.Flags().Changed("compatibility") && !cmd.Flags().Changed("normalize") && !cmd.Flags().Changed("compatibility-group") {
+		return nil, errors.New(errors.ConfigUpdateFlagsErrorMsg)
+	}
+
+	req := new(configUpdateRequest)
+
+	compatibility, err := cmd.Flags().GetString("compatibility")
+	if err != nil {
+		return nil, err
+	}
+	if compatibility != "" {
+		req.Compatibility = strings.ToUpper(compatibility)
+		if !utils.Contains(compatibilityLevels, req.Compatibility) {
+			return nil, errors.NewErrorWithSuggestions(fmt.Sprintf(errors.InvalidCompatibilityErrorMsg, compatibility), fmt.Sprintf(errors.InvalidCompatibilitySuggestions, utils.ArrayToCommaDelimitedString(compatibilityLevels)))
+		}
+	}
+
+	if cmd.Flags().Changed("normalize") {
+		normalize, err := cmd.Flags().GetBool("normalize")
+		if err != nil {
+			return nil, err
+		}
+		req.Normalize = &normalize
+	}
+
+	req.CompatibilityGroup, err = cmd.Flags().GetString("compatibility-group")
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
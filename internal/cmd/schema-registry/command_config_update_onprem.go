@@ -0,0 +1,47 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+func (c *configCommand) newUpdateCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "update",
+		Short:       "Update top-level or subject-level configuration.",
+		Long:        "Update the compatibility, normalization, or compatibility group of Schema Registry, or of a subject.",
+		Args:        cobra.NoArgs,
+		RunE:        c.onPremUpdate,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Update the top-level compatibility to BACKWARD_TRANSITIVE.",
+				Code: fmt.Sprintf("%s schema-registry config update --compatibility BACKWARD_TRANSITIVE %s", pversion.CLIName, OnPremAuthenticationMsg),
+			},
+			examples.Example{
+				Text: `Normalize the schemas of subject "payments", and check compatibility only against versions with the same "application.major.version".`,
+				Code: fmt.Sprintf("%s schema-registry config update --subject payments --normalize --compatibility-group application.major.version %s", pversion.CLIName, OnPremAuthenticationMsg),
+			},
+		),
+	}
+
+	addConfigUpdateFlags(cmd)
+	cmd.Flags().AddFlagSet(pcmd.OnPremSchemaRegistrySet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+
+	return cmd
+}
+
+func (c *configCommand) onPremUpdate(cmd *cobra.Command, _ []string) error {
+	srClient, ctx, err := GetSrApiClientWithToken(cmd, c.Version, c.AuthToken())
+	if err != nil {
+		return err
+	}
+
+	return updateSchemaConfig(cmd, srClient, ctx)
+}
@@ -0,0 +1,34 @@
+package schemaregistry
+
+import (
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	v1 "github.com/confluentinc/cli/internal/pkg/config/v1"
+)
+
+type contextCommand struct {
+	*pcmd.AuthenticatedStateFlagCommand
+	srClient *srsdk.APIClient
+}
+
+func newContextCommand(cfg *v1.Config, prerunner pcmd.PreRunner, srClient *srsdk.APIClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "context",
+		Short:       "Manage Schema Registry contexts.",
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLoginOrOnPremLogin},
+	}
+
+	c := &contextCommand{srClient: srClient}
+
+	if cfg.IsCloudLogin() {
+		c.AuthenticatedStateFlagCommand = pcmd.NewAuthenticatedStateFlagCommand(cmd, prerunner)
+		c.AddCommand(c.newListCommand())
+	} else {
+		c.AuthenticatedStateFlagCommand = pcmd.NewAuthenticatedWithMDSStateFlagCommand(cmd, prerunner)
+		c.AddCommand(c.newListCommandOnPrem())
+	}
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/output"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+type contextListOut struct {
+	Context string `human:"Context" serialized:"context"`
+}
+
+func (c *contextCommand) newListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List Schema Registry contexts.",
+		Args:  cobra.NoArgs,
+		RunE:  c.list,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "List the contexts of Schema Registry.",
+				Code: fmt.Sprintf("%s schema-registry context list", pversion.CLIName),
+			},
+		),
+	}
+
+	pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddApiSecretFlag(cmd)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *contextCommand) list(cmd *cobra.Command, _ []string) error {
+	srClient, ctx, err := getApiClient(cmd, c.srClient, c.Config, c.Version)
+	if err != nil {
+		return err
+	}
+
+	return listContexts(cmd, srClient, ctx)
+}
+
+func listContexts(cmd *cobra.Command, srClient *srsdk.APIClient, ctx context.Context) error {
+	contexts, _, err := srClient.DefaultApi.ListContexts(ctx)
+	if err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	for _, srContext := range contexts {
+		list.Add(&contextListOut{Context: srContext})
+	}
+	return list.Print()
+}
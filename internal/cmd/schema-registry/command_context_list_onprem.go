@@ -0,0 +1,42 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+func (c *contextCommand) newListCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "list",
+		Short:       "List Schema Registry contexts.",
+		Args:        cobra.NoArgs,
+		RunE:        c.onPremList,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "List the contexts of Schema Registry.",
+				Code: fmt.Sprintf("%s schema-registry context list %s", pversion.CLIName, OnPremAuthenticationMsg),
+			},
+		),
+	}
+
+	cmd.Flags().AddFlagSet(pcmd.OnPremSchemaRegistrySet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *contextCommand) onPremList(cmd *cobra.Command, _ []string) error {
+	srClient, ctx, err := GetSrApiClientWithToken(cmd, c.Version, c.AuthToken())
+	if err != nil {
+		return err
+	}
+
+	return listContexts(cmd, srClient, ctx)
+}
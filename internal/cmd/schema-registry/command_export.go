@@ -0,0 +1,82 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	v1 "github.com/confluentinc/cli/internal/pkg/config/v1"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+type exportCommand struct {
+	*pcmd.AuthenticatedStateFlagCommand
+	srClient *srsdk.APIClient
+}
+
+func newExportCommand(cfg *v1.Config, prerunner pcmd.PreRunner, srClient *srsdk.APIClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export all schemas to a directory.",
+		Long: "Export every subject and version of Schema Registry, with their references, compatibility levels, and modes, to a directory which can be imported with `confluent schema-registry import`. " +
+			"Unlike schema exporters, this does not require a connection between the source and destination Schema Registry, and can be used for backups.",
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLoginOrOnPremLogin},
+	}
+
+	c := &exportCommand{srClient: srClient}
+
+	cmd.Flags().String("dir", "", "The directory to export to, which must be empty or not exist.")
+
+	if cfg.IsCloudLogin() {
+		c.AuthenticatedStateFlagCommand = pcmd.NewAuthenticatedStateFlagCommand(cmd, prerunner)
+		cmd.RunE = c.export
+		cmd.Example = examples.BuildExampleString(
+			examples.Example{
+				Text: `Export all schemas to directory "backup".`,
+				Code: fmt.Sprintf("%s schema-registry export --dir backup", pversion.CLIName),
+			},
+		)
+		pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+		pcmd.AddApiSecretFlag(cmd)
+		pcmd.AddContextFlag(cmd, c.CLICommand)
+		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	} else {
+		c.AuthenticatedStateFlagCommand = pcmd.NewAuthenticatedWithMDSStateFlagCommand(cmd, prerunner)
+		cmd.RunE = c.onPremExport
+		cmd.Example = examples.BuildExampleString(
+			examples.Example{
+				Text: `Export all schemas to directory "backup".`,
+				Code: fmt.Sprintf("%s schema-registry export --dir backup %s", pversion.CLIName, OnPremAuthenticationMsg),
+			},
+		)
+		cmd.Flags().AddFlagSet(pcmd.OnPremSchemaRegistrySet())
+		pcmd.AddContextFlag(cmd, c.CLICommand)
+	}
+	pcmd.AddOutputFlag(cmd)
+
+	_ = cmd.MarkFlagRequired("dir")
+
+	return c.Command
+}
+
+func (c *exportCommand) export(cmd *cobra.Command, _ []string) error {
+	srClient, ctx, err := getApiClient(cmd, c.srClient, c.Config, c.Version)
+	if err != nil {
+		return err
+	}
+
+	return exportSchemas(cmd, srClient, ctx)
+}
+
+func (c *exportCommand) onPremExport(cmd *cobra.Command, _ []string) error {
+	srClient, ctx, err := GetSrApiClientWithToken(cmd, c.Version, c.AuthToken())
+	if err != nil {
+		return err
+	}
+
+	return exportSchemas(cmd, srClient, ctx)
+}
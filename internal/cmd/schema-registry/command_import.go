@@ -0,0 +1,84 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	v1 "github.com/confluentinc/cli/internal/pkg/config/v1"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+type importCommand struct {
+	*pcmd.AuthenticatedStateFlagCommand
+	srClient *srsdk.APIClient
+}
+
+func newImportCommand(cfg *v1.Config, prerunner pcmd.PreRunner, srClient *srsdk.APIClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import schemas from a directory.",
+		Long: "Import the subjects, versions, compatibility levels, and modes exported with `confluent schema-registry export`. " +
+			"Schemas are registered after the schemas they reference. " +
+			"If `--preserve-ids` is set, the schemas keep their IDs and versions, which requires Schema Registry to be in IMPORT mode; otherwise, they are assigned new IDs and versions, and references are updated to match.",
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLoginOrOnPremLogin},
+	}
+
+	c := &importCommand{srClient: srClient}
+
+	cmd.Flags().String("dir", "", "The directory to import from.")
+	cmd.Flags().Bool("preserve-ids", false, "Preserve the IDs and versions of the schemas.")
+
+	if cfg.IsCloudLogin() {
+		c.AuthenticatedStateFlagCommand = pcmd.NewAuthenticatedStateFlagCommand(cmd, prerunner)
+		cmd.RunE = c.importSchemas
+		cmd.Example = examples.BuildExampleString(
+			examples.Example{
+				Text: `Import the schemas in directory "backup", preserving their IDs.`,
+				Code: fmt.Sprintf("%s schema-registry import --dir backup --preserve-ids", pversion.CLIName),
+			},
+		)
+		pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+		pcmd.AddApiSecretFlag(cmd)
+		pcmd.AddContextFlag(cmd, c.CLICommand)
+		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	} else {
+		c.AuthenticatedStateFlagCommand = pcmd.NewAuthenticatedWithMDSStateFlagCommand(cmd, prerunner)
+		cmd.RunE = c.onPremImportSchemas
+		cmd.Example = examples.BuildExampleString(
+			examples.Example{
+				Text: `Import the schemas in directory "backup", preserving their IDs.`,
+				Code: fmt.Sprintf("%s schema-registry import --dir backup --preserve-ids %s", pversion.CLIName, OnPremAuthenticationMsg),
+			},
+		)
+		cmd.Flags().AddFlagSet(pcmd.OnPremSchemaRegistrySet())
+		pcmd.AddContextFlag(cmd, c.CLICommand)
+	}
+	pcmd.AddOutputFlag(cmd)
+
+	_ = cmd.MarkFlagRequired("dir")
+
+	return c.Command
+}
+
+func (c *importCommand) importSchemas(cmd *cobra.Command, _ []string) error {
+	srClient, ctx, err := getApiClient(cmd, c.srClient, c.Config, c.Version)
+	if err != nil {
+		return err
+	}
+
+	return importSchemas(cmd, srClient, ctx)
+}
+
+func (c *importCommand) onPremImportSchemas(cmd *cobra.Command, _ []string) error {
+	srClient, ctx, err := GetSrApiClientWithToken(cmd, c.Version, c.AuthToken())
+	if err != nil {
+		return err
+	}
+
+	return importSchemas(cmd, srClient, ctx)
+}
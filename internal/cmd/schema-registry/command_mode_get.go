@@ -0,0 +1,82 @@
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/output"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+type modeOut struct {
+	Mode string `human:"Mode" serialized:"mode"`
+}
+
+func (c *modeCommand) newGetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get top-level or subject-level mode.",
+		Args:  cobra.NoArgs,
+		RunE:  c.get,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Get the top-level mode.",
+				Code: fmt.Sprintf("%s schema-registry mode get", pversion.CLIName),
+			},
+			examples.Example{
+				Text: `Get the mode of subject "payments".`,
+				Code: fmt.Sprintf("%s schema-registry mode get --subject payments", pversion.CLIName),
+			},
+		),
+	}
+
+	cmd.Flags().String("subject", "", SubjectUsage)
+	pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddApiSecretFlag(cmd)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *modeCommand) get(cmd *cobra.Command, _ []string) error {
+	srClient, ctx, err := getApiClient(cmd, c.srClient, c.Config, c.Version)
+	if err != nil {
+		return err
+	}
+
+	return getMode(cmd, srClient, ctx)
+}
+
+func getMode(cmd *cobra.Command, srClient *srsdk.APIClient, ctx context.Context) error {
+	subject, err := cmd.Flags().GetString("subject")
+	if err != nil {
+		return err
+	}
+
+	var mode srsdk.Mode
+	var httpResp *http.Response
+	if subject != "" {
+		mode, httpResp, err = srClient.DefaultApi.GetMode(ctx, subject, nil)
+		if err != nil {
+			return errors.CatchNoSubjectLevelModeError(err, httpResp, subject)
+		}
+	} else {
+		mode, _, err = srClient.DefaultApi.GetTopLevelMode(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	table := output.NewTable(cmd)
+	table.Add(&modeOut{Mode: mode.Mode})
+	return table.Print()
+}
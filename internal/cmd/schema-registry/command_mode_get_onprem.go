@@ -0,0 +1,47 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+func (c *modeCommand) newGetCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "get",
+		Short:       "Get top-level or subject-level mode.",
+		Args:        cobra.NoArgs,
+		RunE:        c.onPremGet,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Get the top-level mode.",
+				Code: fmt.Sprintf("%s schema-registry mode get %s", pversion.CLIName, OnPremAuthenticationMsg),
+			},
+			examples.Example{
+				Text: `Get the mode of subject "payments".`,
+				Code: fmt.Sprintf("%s schema-registry mode get --subject payments %s", pversion.CLIName, OnPremAuthenticationMsg),
+			},
+		),
+	}
+
+	cmd.Flags().String("subject", "", SubjectUsage)
+	cmd.Flags().AddFlagSet(pcmd.OnPremSchemaRegistrySet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *modeCommand) onPremGet(cmd *cobra.Command, _ []string) error {
+	srClient, ctx, err := GetSrApiClientWithToken(cmd, c.Version, c.AuthToken())
+	if err != nil {
+		return err
+	}
+
+	return getMode(cmd, srClient, ctx)
+}
@@ -0,0 +1,82 @@
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+func (c *modeCommand) newSetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:       "set <mode>",
+		Short:     "Set top-level or subject-level mode.",
+		Long:      "Set the top-level mode, or the mode of a subject, to READWRITE, READONLY, or IMPORT.",
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: modes,
+		RunE:      c.set,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Set the top-level mode to IMPORT before migrating schemas.",
+				Code: fmt.Sprintf("%s schema-registry mode set IMPORT", pversion.CLIName),
+			},
+			examples.Example{
+				Text: `Set the mode of subject "payments" to READONLY.`,
+				Code: fmt.Sprintf("%s schema-registry mode set READONLY --subject payments", pversion.CLIName),
+			},
+		),
+	}
+
+	cmd.Flags().String("subject", "", SubjectUsage)
+	pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddApiSecretFlag(cmd)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+
+	return cmd
+}
+
+func (c *modeCommand) set(cmd *cobra.Command, args []string) error {
+	srClient, ctx, err := getApiClient(cmd, c.srClient, c.Config, c.Version)
+	if err != nil {
+		return err
+	}
+
+	return setMode(cmd, args[0], srClient, ctx)
+}
+
+func setMode(cmd *cobra.Command, mode string, srClient *srsdk.APIClient, ctx context.Context) error {
+	req := srsdk.ModeUpdateRequest{Mode: strings.ToUpper(mode)}
+	if !utils.Contains(modes, req.Mode) {
+		return errors.NewErrorWithSuggestions(fmt.Sprintf(errors.InvalidModeErrorMsg, mode), fmt.Sprintf(errors.InvalidModeSuggestions, utils.ArrayToCommaDelimitedString(modes)))
+	}
+
+	subject, err := cmd.Flags().GetString("subject")
+	if err != nil {
+		return err
+	}
+
+	if subject != "" {
+		updatedMode, httpResp, err := srClient.DefaultApi.UpdateMode(ctx, subject, req)
+		if err != nil {
+			return errors.CatchSchemaNotFoundError(err, httpResp)
+		}
+		utils.Printf(cmd, errors.UpdatedSubjectLevelModeMsg, updatedMode.Mode, subject)
+		return nil
+	}
+
+	updatedMode, _, err := srClient.DefaultApi.UpdateTopLevelMode(ctx, req)
+	if err != nil {
+		return err
+	}
+	utils.Printf(cmd, errors.UpdatedTopLevelModeMsg, updatedMode.Mode)
+	return nil
+}
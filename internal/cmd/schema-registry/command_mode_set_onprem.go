@@ -0,0 +1,48 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+func (c *modeCommand) newSetCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "set <mode>",
+		Short:       "Set top-level or subject-level mode.",
+		Long:        "Set the top-level mode, or the mode of a subject, to READWRITE, READONLY, or IMPORT.",
+		Args:        cobra.ExactArgs(1),
+		ValidArgs:   modes,
+		RunE:        c.onPremSet,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Set the top-level mode to IMPORT before migrating schemas.",
+				Code: fmt.Sprintf("%s schema-registry mode set IMPORT %s", pversion.CLIName, OnPremAuthenticationMsg),
+			},
+			examples.Example{
+				Text: `Set the mode of subject "payments" to READONLY.`,
+				Code: fmt.Sprintf("%s schema-registry mode set READONLY --subject payments %s", pversion.CLIName, OnPremAuthenticationMsg),
+			},
+		),
+	}
+
+	cmd.Flags().String("subject", "", SubjectUsage)
+	cmd.Flags().AddFlagSet(pcmd.OnPremSchemaRegistrySet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+
+	return cmd
+}
+
+func (c *modeCommand) onPremSet(cmd *cobra.Command, args []string) error {
+	srClient, ctx, err := GetSrApiClientWithToken(cmd, c.Version, c.AuthToken())
+	if err != nil {
+		return err
+	}
+
+	return setMode(cmd, args[0], srClient, ctx)
+}
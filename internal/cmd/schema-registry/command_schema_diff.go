@@ -0,0 +1,211 @@
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/antihax/optional"
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/output"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+const schemaDiffLongDescription = "Compare two versions of a subject, or a version of a subject and a local schema file. " +
+	"Avro, JSON, and Protobuf schemas are compared semantically, listing added and removed fields, changed defaults, and type promotions. " +
+	"Each change is marked as compatible or not under the compatibility level of the subject."
+
+type schemaDiffOut struct {
+	Path       string `human:"Path" serialized:"path"`
+	Change     string `human:"Change" serialized:"change"`
+	Before     string `human:"Before,omitempty" serialized:"before,omitempty"`
+	After      string `human:"After,omitempty" serialized:"after,omitempty"`
+	Compatible bool   `human:"Compatible" serialized:"compatible"`
+}
+
+func (c *schemaCommand) newDiffCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare two versions of a schema.",
+		Long:  schemaDiffLongDescription,
+		Args:  cobra.NoArgs,
+		RunE:  c.diff,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Compare versions 1 and 2 of subject `payments`.",
+				Code: fmt.Sprintf("%s schema-registry schema diff --subject payments --version 1 --version 2", pversion.CLIName),
+			},
+			examples.Example{
+				Text: "Compare the latest version of subject `payments` to a local schema file.",
+				Code: fmt.Sprintf("%s schema-registry schema diff --subject payments --schema payments.avsc", pversion.CLIName),
+			},
+		),
+	}
+
+	addSchemaDiffFlags(cmd)
+	pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddApiSecretFlag(cmd)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func addSchemaDiffFlags(cmd *cobra.Command) {
+	cmd.Flags().String("subject", "", SubjectUsage)
+	cmd.Flags().StringSlice("version", nil, `Version of the schema. Can be a specific version or "latest". Pass twice to compare two versions.`)
+	cmd.Flags().String("schema", "", "The path to a schema file to compare to the version.")
+	pcmd.AddSchemaTypeFlag(cmd)
+	cmd.Flags().String("references", "", "The path to the references file of the schema file.")
+
+	_ = cmd.MarkFlagRequired("subject")
+}
+
+func (c *schemaCommand) diff(cmd *cobra.Command, _ []string) error {
+	srClient, ctx, err := getApiClient(cmd, c.srClient, c.Config, c.Version)
+	if err != nil {
+		return err
+	}
+
+	return diffSchemaVersions(cmd, srClient, ctx)
+}
+
+func diffSchemaVersions(cmd *cobra.Command, srClient *srsdk.APIClient, ctx context.Context) error {
+	subject, err := cmd.Flags().GetString("subject")
+	if err != nil {
+		return err
+	}
+
+	versions, err := cmd.Flags().GetStringSlice("version")
+	if err != nil {
+		return err
+	}
+
+	schemaPath, err := cmd.Flags().GetString("schema")
+	if err != nil {
+		return err
+	}
+
+	if schemaPath == "" && len(versions) != 2 || schemaPath != "" && len(versions) > 1 {
+		return errors.New(errors.SchemaDiffVersionsErrorMsg)
+	}
+	if len(versions) == 0 {
+		versions = []string{"latest"}
+	}
+
+	before, err := getSchemaDocument(srClient, ctx, subject, versions[0])
+	if err != nil {
+		return err
+	}
+
+	var after *schemaDocument
+	if schemaPath != "" {
+		after, err = readSchemaDocument(cmd, srClient, ctx, schemaPath, before.schemaType)
+	} else {
+		after, err = getSchemaDocument(srClient, ctx, subject, versions[1])
+	}
+	if err != nil {
+		return err
+	}
+
+	changes, err := diffSchemas(before, after)
+	if err != nil {
+		return err
+	}
+
+	config, _, err := srClient.DefaultApi.GetSubjectLevelConfig(ctx, subject, &srsdk.GetSubjectLevelConfigOpts{DefaultToGlobal: optional.NewBool(true)})
+	if err != nil {
+		return err
+	}
+
+	if output.GetFormat(cmd) == output.Human {
+		utils.Printf(cmd, errors.SchemaCompatibilityLevelMsg, subject, config.CompatibilityLevel)
+		if len(changes) == 0 {
+			utils.Print(cmd, errors.NoSchemaDifferencesMsg)
+			return nil
+		}
+	}
+
+	list := output.NewList(cmd)
+	for _, change := range changes {
+		list.Add(&schemaDiffOut{
+			Path:       change.path,
+			Change:     change.change,
+			Before:     change.before,
+			After:      change.after,
+			Compatible: change.isCompatible(config.CompatibilityLevel),
+		})
+	}
+	return list.Print()
+}
+
+func getSchemaDocument(srClient *srsdk.APIClient, ctx context.Context, subject, version string) (*schemaDocument, error) {
+	schema, httpResp, err := srClient.DefaultApi.GetSchemaByVersion(ctx, subject, version, nil)
+	if err != nil {
+		return nil, errors.CatchSchemaNotFoundError(err, httpResp)
+	}
+
+	references, err := getSchemaReferences(srClient, ctx, schema.References)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemaDocument{schemaType: normalizeSchemaType(schema.SchemaType), schema: schema.Schema, references: references}, nil
+}
+
+// readSchemaDocument reads a local schema file, which is of the same type as the version it is compared to unless `--type` is passed.
+func readSchemaDocument(cmd *cobra.Command, srClient *srsdk.APIClient, ctx context.Context, schemaPath, schemaType string) (*schemaDocument, error) {
+	schema, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.Flags().Changed("type") {
+		schemaType, err = cmd.Flags().GetString("type")
+		if err != nil {
+			return nil, err
+		}
+		schemaType = normalizeSchemaType(schemaType)
+	}
+
+	refs, err := ReadSchemaRefs(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	references, err := getSchemaReferences(srClient, ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemaDocument{schemaType: schemaType, schema: string(schema), references: references}, nil
+}
+
+// getSchemaReferences returns the contents of the referenced schemas, and of the schemas they reference in turn, keyed by reference name.
+func getSchemaReferences(srClient *srsdk.APIClient, ctx context.Context, refs []srsdk.SchemaReference) (map[string]string, error) {
+	references := map[string]string{}
+	for len(refs) > 0 {
+		ref := refs[0]
+		refs = refs[1:]
+		if _, ok := references[ref.Name]; ok {
+			continue
+		}
+
+		schema, httpResp, err := srClient.DefaultApi.GetSchemaByVersion(ctx, ref.Subject, strconv.Itoa(int(ref.Version)), nil)
+		if err != nil {
+			return nil, errors.CatchSchemaNotFoundError(err, httpResp)
+		}
+
+		references[ref.Name] = schema.Schema
+		refs = append(refs, schema.References...)
+	}
+	return references, nil
+}
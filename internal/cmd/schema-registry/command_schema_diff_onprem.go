@@ -0,0 +1,48 @@
+package schemaregistry
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+func (c *schemaCommand) newDiffCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "diff",
+		Short:       "Compare two versions of a schema.",
+		Long:        schemaDiffLongDescription,
+		Args:        cobra.NoArgs,
+		RunE:        c.onPremDiff,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Compare versions 1 and 2 of subject `payments`.",
+				Code: fmt.Sprintf("%s schema-registry schema diff --subject payments --version 1 --version 2 %s", pversion.CLIName, OnPremAuthenticationMsg),
+			},
+			examples.Example{
+				Text: "Compare the latest version of subject `payments` to a local schema file.",
+				Code: fmt.Sprintf("%s schema-registry schema diff --subject payments --schema payments.avsc %s", pversion.CLIName, OnPremAuthenticationMsg),
+			},
+		),
+	}
+
+	addSchemaDiffFlags(cmd)
+	cmd.Flags().AddFlagSet(pcmd.OnPremSchemaRegistrySet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *schemaCommand) onPremDiff(cmd *cobra.Command, _ []string) error {
+	srClient, ctx, err := GetSrApiClientWithToken(cmd, c.Version, c.AuthToken())
+	if err != nil {
+		return err
+	}
+
+	return diffSchemaVersions(cmd, srClient, ctx)
+}
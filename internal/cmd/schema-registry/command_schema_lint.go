@@ -0,0 +1,126 @@
+package schemaregistry
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/examples"
+	"github.com/confluentinc/cli/internal/pkg/output"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+	pversion "github.com/confluentinc/cli/internal/pkg/version"
+)
+
+// schemaFileTypes maps the extensions of schema files to their types, for when `--type` is not passed.
+var schemaFileTypes = map[string]string{
+	".avsc":  avroSchemaType,
+	".avro":  avroSchemaType,
+	".json":  jsonSchemaType,
+	".proto": protobufSchemaType,
+}
+
+type schemaLintOut struct {
+	Path    string `human:"Path" serialized:"path"`
+	Rule    string `human:"Rule" serialized:"rule"`
+	Message string `human:"Message" serialized:"message"`
+}
+
+func newLintCommand(prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint <file>",
+		Short: "Lint a local schema file.",
+		Long: "Parse a local Avro, JSON, or Protobuf schema file without connecting to Schema Registry, and report syntax errors and style issues. " +
+			"By default, records, enums, messages, fields, and properties must be documented, and Avro fields which are not nullable must have a default. " +
+			"The rules file may disable these checks with `require_docs: false` and `require_defaults: false`, " +
+			"and may set the naming convention of `types`, `fields`, and `enum_symbols` under `naming` to PascalCase, camelCase, snake_case, or UPPER_SNAKE_CASE. " +
+			"The command fails if any issues are found, so it can be run in pre-commit hooks.",
+		Args:        cobra.ExactArgs(1),
+		RunE:        lint,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.NoRunRequirement},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Lint the Avro schema `payments.avsc` with the rules in `lint-rules.yaml`.",
+				Code: fmt.Sprintf("%s schema-registry schema lint payments.avsc --rules lint-rules.yaml", pversion.CLIName),
+			},
+			examples.Example{
+				Text: "Lint a Protobuf schema which imports files from directory `protos`.",
+				Code: fmt.Sprintf("%s schema-registry schema lint payments.proto --references protos", pversion.CLIName),
+			},
+		),
+	}
+
+	pcmd.AddSchemaTypeFlag(cmd)
+	cmd.Flags().StringSlice("references", nil, "A comma-separated list of local files or directories containing the schemas referenced by the schema.")
+	cmd.Flags().String("rules", "", "The path to a YAML or JSON file of lint rules.")
+	pcmd.AddOutputFlag(cmd)
+
+	return pcmd.NewAnonymousCLICommand(cmd, prerunner).Command
+}
+
+func lint(cmd *cobra.Command, args []string) error {
+	schemaType, err := cmd.Flags().GetString("type")
+	if err != nil {
+		return err
+	}
+	if schemaType == "" {
+		schemaType = schemaFileTypes[strings.ToLower(filepath.Ext(args[0]))]
+	}
+	schemaType = normalizeSchemaType(schemaType)
+
+	referencePaths, err := cmd.Flags().GetStringSlice("references")
+	if err != nil {
+		return err
+	}
+
+	rulesFile, err := cmd.Flags().GetString("rules")
+	if err != nil {
+		return err
+	}
+
+	rules, err := readSchemaLintRules(rulesFile)
+	if err != nil {
+		return err
+	}
+
+	schema, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	references, err := readSchemaLintReferences(referencePaths)
+	if err != nil {
+		return err
+	}
+
+	issues, err := lintSchema(&schemaDocument{schemaType: schemaType, schema: string(schema), references: references}, args[0], rules)
+	if err != nil {
+		return err
+	}
+
+	if len(issues) == 0 && output.GetFormat(cmd) == output.Human {
+		utils.Printf(cmd, errors.NoSchemaLintIssuesMsg, args[0])
+		return nil
+	}
+
+	list := output.NewList(cmd)
+	for _, issue := range issues {
+		list.Add(&schemaLintOut{
+			Path:    issue.path,
+			Rule:    issue.rule,
+			Message: issue.message,
+		})
+	}
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	if len(issues) > 0 {
+		return errors.Errorf(errors.SchemaLintIssuesErrorMsg, len(issues), args[0])
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package schemaregistry
 
 import (
+	"bytes"
 	"context"
 	"net/http"
 	"os"
@@ -199,6 +200,29 @@ func (suite *SchemaTestSuite) TestDescribeBySubjectVersion() {
 	req.Equal(retVal.Version, versionString)
 }
 
+func (suite *SchemaTestSuite) TestDiffSchemaVersions() {
+	apiMock, _ := suite.srClientMock.DefaultApi.(*srMock.DefaultApi)
+	apiMock.GetSchemaByVersionFunc = func(_ context.Context, _, version string, _ *srsdk.GetSchemaByVersionOpts) (srsdk.Schema, *http.Response, error) {
+		if version == "1" {
+			return srsdk.Schema{Schema: `{"type": "record", "name": "Payment", "fields": [{"name": "amount", "type": "int"}]}`}, nil, nil
+		}
+		return srsdk.Schema{Schema: `{"type": "record", "name": "Payment", "fields": [{"name": "amount", "type": "long"}]}`}, nil, nil
+	}
+	apiMock.GetSubjectLevelConfigFunc = func(_ context.Context, _ string, _ *srsdk.GetSubjectLevelConfigOpts) (srsdk.Config, *http.Response, error) {
+		return srsdk.Config{CompatibilityLevel: "FORWARD"}, nil, nil
+	}
+
+	cmd := suite.newCMD()
+	cmd.SetArgs([]string{"schema", "diff", "--subject", subjectName, "--version", "1", "--version", "2", "--output", "json"})
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	err := cmd.Execute()
+	req := require.New(suite.T())
+	req.Nil(err)
+	req.JSONEq(`[{"path": "Payment.amount", "change": "type promoted", "before": "int", "after": "long", "compatible": false}]`, buf.String())
+	req.Equal(subjectName, apiMock.GetSubjectLevelConfigCalls()[0].Subject)
+}
+
 func TestSchemaSuite(t *testing.T) {
 	suite.Run(t, new(SchemaTestSuite))
 }
@@ -166,6 +166,29 @@ func GetSchemaRegistryClientWithApiKey(cmd *cobra.Command, cfg *dynamicconfig.Dy
 	}
 }
 
+// GetSchemaRegistryClientWithEndpoint returns a client for the Schema Registry cluster at the given endpoint,
+// without falling back to the Schema Registry cluster of the current context or prompting for credentials.
+func GetSchemaRegistryClientWithEndpoint(cmd *cobra.Command, ver *version.Version, endpoint, srAPIKey, srAPISecret string) (*srsdk.APIClient, context.Context, error) {
+	unsafeTrace, err := cmd.Flags().GetBool("unsafe-trace")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	srConfig := srsdk.NewConfiguration()
+	srConfig.BasePath = endpoint
+	srConfig.UserAgent = ver.UserAgent
+	srConfig.Debug = unsafeTrace
+	srConfig.HTTPClient = utils.DefaultClient()
+	srClient := srsdk.NewAPIClient(srConfig)
+
+	srCtx := context.WithValue(context.Background(), srsdk.ContextBasicAuth, srsdk.BasicAuth{UserName: srAPIKey, Password: srAPISecret})
+	if _, _, err := srClient.DefaultApi.Get(srCtx); err != nil {
+		return nil, nil, errors.Wrap(err, errors.SRCredsValidationFailedErrorMsg)
+	}
+
+	return srClient, srCtx, nil
+}
+
 func getSchemaRegistryClientWithToken(cmd *cobra.Command, ver *version.Version, mdsToken string) (*srsdk.APIClient, context.Context, error) {
 	srConfig := srsdk.NewConfiguration()
 
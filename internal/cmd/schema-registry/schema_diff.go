@@ -0,0 +1,127 @@
+package schemaregistry
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/confluentinc/cli/internal/pkg/errors"
+)
+
+const (
+	avroSchemaType     = "AVRO"
+	jsonSchemaType     = "JSON"
+	protobufSchemaType = "PROTOBUF"
+)
+
+const (
+	fieldAddedChange       = "field added"
+	fieldRemovedChange     = "field removed"
+	fieldRenamedChange     = "field renamed"
+	defaultAddedChange     = "default added"
+	defaultRemovedChange   = "default removed"
+	defaultChangedChange   = "default changed"
+	typePromotedChange     = "type promoted"
+	typeChangedChange      = "type changed"
+	symbolAddedChange      = "symbol added"
+	symbolRemovedChange    = "symbol removed"
+	branchAddedChange      = "union branch added"
+	branchRemovedChange    = "union branch removed"
+	fieldRequiredChange    = "field made required"
+	fieldOptionalChange    = "field made optional"
+	propertiesClosedChange = "additional properties closed"
+	propertiesOpenedChange = "additional properties opened"
+	referenceChangedChange = "reference changed"
+	labelChangedChange     = "label changed"
+	messageAddedChange     = "message added"
+	messageRemovedChange   = "message removed"
+)
+
+// schemaDocument is a schema along with the contents of the schemas it references, keyed by reference name.
+type schemaDocument struct {
+	schemaType string
+	schema     string
+	references map[string]string
+}
+
+// schemaChange is a semantic difference between an old and a new version of a schema.
+type schemaChange struct {
+	path   string
+	change string
+	before string
+	after  string
+	// backward is true if consumers using the new schema can read data written with the old schema.
+	backward bool
+	// forward is true if consumers using the old schema can read data written with the new schema.
+	forward bool
+}
+
+// isCompatible returns whether a change is allowed under a subject's compatibility level.
+func (c *schemaChange) isCompatible(level string) bool {
+	switch strings.ToUpper(level) {
+	case "BACKWARD", "BACKWARD_TRANSITIVE":
+		return c.backward
+	case "FORWARD", "FORWARD_TRANSITIVE":
+		return c.forward
+	case "FULL", "FULL_TRANSITIVE":
+		return c.backward && c.forward
+	default:
+		return true
+	}
+}
+
+// diffSchemas returns the changes from an old to a new schema, which must be of the same type.
+func diffSchemas(before, after *schemaDocument) ([]*schemaChange, error) {
+	if before.schemaType != after.schemaType {
+		return nil, errors.Errorf(errors.SchemaTypeMismatchErrorMsg, before.schemaType, after.schemaType)
+	}
+
+	switch before.schemaType {
+	case avroSchemaType:
+		return diffAvroSchemas(before, after)
+	case jsonSchemaType:
+		return diffJsonSchemas(before, after)
+	case protobufSchemaType:
+		return diffProtobufSchemas(before, after)
+	default:
+		return nil, errors.Errorf(errors.UnknownSchemaTypeErrorMsg, before.schemaType)
+	}
+}
+
+// normalizeSchemaType converts a schema type from a flag or from Schema Registry, where Avro is the default, to its canonical form.
+func normalizeSchemaType(schemaType string) string {
+	switch strings.ToUpper(schemaType) {
+	case "", avroSchemaType:
+		return avroSchemaType
+	case jsonSchemaType, "JSONSCHEMA":
+		return jsonSchemaType
+	default:
+		return strings.ToUpper(schemaType)
+	}
+}
+
+func marshalJsonValue(v interface{}) string {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(out)
+}
+
+func sortedNames(names map[string]bool) []string {
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+func sortedReferenceNames(references map[string]string) []string {
+	names := make([]string, 0, len(references))
+	for name := range references {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,442 @@
+package schemaregistry
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+)
+
+var avroPrimitiveTypes = []string{"null", "boolean", "int", "long", "float", "double", "bytes", "string"}
+
+// avroPromotions maps each Avro primitive type to the types that a reader may promote it to.
+var avroPromotions = map[string][]string{
+	"int":    {"long", "float", "double"},
+	"long":   {"float", "double"},
+	"float":  {"double"},
+	"string": {"bytes"},
+	"bytes":  {"string"},
+}
+
+// avroType is a parsed Avro schema. Named types are shared, so recursive records form a cycle.
+type avroType struct {
+	kind          string
+	name          string
+	doc           string
+	logicalType   string
+	fields        []*avroField
+	symbols       []string
+	defaultSymbol string
+	size          int
+	items         *avroType
+	branches      []*avroType
+}
+
+type avroField struct {
+	name         string
+	doc          string
+	aliases      []string
+	typ          *avroType
+	hasDefault   bool
+	defaultValue string
+}
+
+type avroParser struct {
+	types map[string]*avroType
+}
+
+func diffAvroSchemas(before, after *schemaDocument) ([]*schemaChange, error) {
+	beforeType, err := parseAvroSchema(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterType, err := parseAvroSchema(after)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "$"
+	if afterType.name != "" {
+		path = shortAvroName(afterType.name)
+	}
+
+	d := &avroDiffer{visited: map[string]bool{}}
+	d.diff(path, beforeType, afterType)
+	return d.changes, nil
+}
+
+// parseAvroSchema parses a schema after its references, which define the named types it may use.
+func parseAvroSchema(doc *schemaDocument) (*avroType, error) {
+	p := &avroParser{types: map[string]*avroType{}}
+
+	for _, name := range sortedReferenceNames(doc.references) {
+		if _, err := p.parseString(doc.references[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	return p.parseString(doc.schema)
+}
+
+func (p *avroParser) parseString(schema string) (*avroType, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(schema), &v); err != nil {
+		return nil, errors.Errorf(errors.ParseSchemaErrorMsg, avroSchemaType, err)
+	}
+
+	t, err := p.parse(v, "")
+	if err != nil {
+		return nil, errors.Errorf(errors.ParseSchemaErrorMsg, avroSchemaType, err)
+	}
+	return t, nil
+}
+
+func (p *avroParser) parse(v interface{}, namespace string) (*avroType, error) {
+	switch schema := v.(type) {
+	case string:
+		if utils.Contains(avroPrimitiveTypes, schema) {
+			return &avroType{kind: schema}, nil
+		}
+		if t, ok := p.types[fullAvroName(schema, namespace)]; ok {
+			return t, nil
+		}
+		if t, ok := p.types[schema]; ok {
+			return t, nil
+		}
+		return nil, fmt.Errorf(`unknown type "%s"`, schema)
+	case []interface{}:
+		t := &avroType{kind: "union"}
+		for _, branch := range schema {
+			branchType, err := p.parse(branch, namespace)
+			if err != nil {
+				return nil, err
+			}
+			t.branches = append(t.branches, branchType)
+		}
+		return t, nil
+	case map[string]interface{}:
+		return p.parseObject(schema, namespace)
+	default:
+		return nil, fmt.Errorf("invalid schema %v", v)
+	}
+}
+
+func (p *avroParser) parseObject(schema map[string]interface{}, namespace string) (*avroType, error) {
+	kind, _ := schema["type"].(string)
+	switch kind {
+	case "record", "error", "enum", "fixed":
+		return p.parseNamed(schema, kind, namespace)
+	case "array":
+		items, err := p.parse(schema["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroType{kind: kind, items: items}, nil
+	case "map":
+		values, err := p.parse(schema["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroType{kind: kind, items: values}, nil
+	}
+
+	t, err := p.parse(schema["type"], namespace)
+	if err != nil {
+		return nil, err
+	}
+	if logicalType, ok := schema["logicalType"].(string); ok && t.name == "" {
+		t = &avroType{kind: t.kind, logicalType: logicalType, items: t.items, branches: t.branches}
+	}
+	return t, nil
+}
+
+func (p *avroParser) parseNamed(schema map[string]interface{}, kind, namespace string) (*avroType, error) {
+	name, _ := schema["name"].(string)
+	if name == "" {
+		return nil, fmt.Errorf("%s has no name", kind)
+	}
+	if ns, ok := schema["namespace"].(string); ok && !strings.Contains(name, ".") {
+		namespace = ns
+	}
+	name = fullAvroName(name, namespace)
+	if i := strings.LastIndex(name, "."); i != -1 {
+		namespace = name[:i]
+	}
+
+	if kind == "error" {
+		kind = "record"
+	}
+	t := &avroType{kind: kind, name: name}
+	t.doc, _ = schema["doc"].(string)
+	// Register the type before parsing its fields, since a record may refer to itself.
+	p.types[name] = t
+
+	switch kind {
+	case "record":
+		fields, _ := schema["fields"].([]interface{})
+		for _, f := range fields {
+			fieldSchema, ok := f.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid field of record %s", name)
+			}
+			field, err := p.parseField(fieldSchema, namespace)
+			if err != nil {
+				return nil, err
+			}
+			t.fields = append(t.fields, field)
+		}
+	case "enum":
+		symbols, _ := schema["symbols"].([]interface{})
+		for _, symbol := range symbols {
+			t.symbols = append(t.symbols, fmt.Sprint(symbol))
+		}
+		t.defaultSymbol, _ = schema["default"].(string)
+	case "fixed":
+		size, _ := schema["size"].(float64)
+		t.size = int(size)
+	}
+
+	return t, nil
+}
+
+func (p *avroParser) parseField(schema map[string]interface{}, namespace string) (*avroField, error) {
+	name, _ := schema["name"].(string)
+	typ, err := p.parse(schema["type"], namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	field := &avroField{name: name, typ: typ}
+	field.doc, _ = schema["doc"].(string)
+	if defaultValue, ok := schema["default"]; ok {
+		field.hasDefault = true
+		field.defaultValue = marshalJsonValue(defaultValue)
+	}
+	aliases, _ := schema["aliases"].([]interface{})
+	for _, alias := range aliases {
+		field.aliases = append(field.aliases, fmt.Sprint(alias))
+	}
+	return field, nil
+}
+
+func fullAvroName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func shortAvroName(name string) string {
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// String returns a short description of a type, such as "int", "array<string>", or "[null, Order]".
+func (t *avroType) String() string {
+	switch t.kind {
+	case "record", "enum", "fixed":
+		return shortAvroName(t.name)
+	case "array", "map":
+		return fmt.Sprintf("%s<%s>", t.kind, t.items)
+	case "union":
+		branches := make([]string, len(t.branches))
+		for i, branch := range t.branches {
+			branches[i] = branch.String()
+		}
+		return "[" + strings.Join(branches, ", ") + "]"
+	default:
+		if t.logicalType != "" {
+			return fmt.Sprintf("%s (%s)", t.kind, t.logicalType)
+		}
+		return t.kind
+	}
+}
+
+// canReadAvro returns whether data written with the writer's type can be read with the reader's type,
+// without looking inside of records, whose fields are compared separately.
+func canReadAvro(reader, writer *avroType) bool {
+	if writer.kind == "union" {
+		for _, branch := range writer.branches {
+			if !canReadAvro(reader, branch) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if reader.kind == "union" {
+		for _, branch := range reader.branches {
+			if canReadAvro(branch, writer) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if reader.kind != writer.kind {
+		return utils.Contains(avroPromotions[writer.kind], reader.kind)
+	}
+
+	switch reader.kind {
+	case "record", "enum":
+		return shortAvroName(reader.name) == shortAvroName(writer.name)
+	case "fixed":
+		return shortAvroName(reader.name) == shortAvroName(writer.name) && reader.size == writer.size
+	case "array", "map":
+		return canReadAvro(reader.items, writer.items)
+	default:
+		return true
+	}
+}
+
+type avroDiffer struct {
+	changes []*schemaChange
+	visited map[string]bool
+}
+
+func (d *avroDiffer) add(change *schemaChange) {
+	d.changes = append(d.changes, change)
+}
+
+func (d *avroDiffer) diff(path string, before, after *avroType) {
+	if before.kind == after.kind {
+		switch before.kind {
+		case "record":
+			if shortAvroName(before.name) == shortAvroName(after.name) {
+				d.diffRecords(path, before, after)
+				return
+			}
+		case "enum":
+			if shortAvroName(before.name) == shortAvroName(after.name) {
+				d.diffEnums(path, before, after)
+				return
+			}
+		case "array":
+			d.diff(path+"[]", before.items, after.items)
+			return
+		case "map":
+			d.diff(path+"{}", before.items, after.items)
+			return
+		case "union":
+			d.diffUnions(path, before, after)
+			return
+		}
+	}
+
+	if before.String() == after.String() {
+		return
+	}
+
+	change := &schemaChange{
+		path:     path,
+		change:   typeChangedChange,
+		before:   before.String(),
+		after:    after.String(),
+		backward: canReadAvro(after, before),
+		forward:  canReadAvro(before, after),
+	}
+	if change.backward && !change.forward {
+		change.change = typePromotedChange
+	}
+	d.add(change)
+}
+
+func (d *avroDiffer) diffRecords(path string, before, after *avroType) {
+	key := before.name + "|" + after.name
+	if d.visited[key] {
+		return
+	}
+	d.visited[key] = true
+
+	matched := map[*avroField]bool{}
+	for _, oldField := range before.fields {
+		newField := findAvroField(after, oldField.name)
+		fieldPath := path + "." + oldField.name
+		if newField == nil {
+			d.add(&schemaChange{path: fieldPath, change: fieldRemovedChange, before: oldField.typ.String(), backward: true, forward: oldField.hasDefault})
+			continue
+		}
+		matched[newField] = true
+
+		if newField.name != oldField.name {
+			fieldPath = path + "." + newField.name
+			d.add(&schemaChange{path: fieldPath, change: fieldRenamedChange, before: oldField.name, after: newField.name, backward: true, forward: oldField.hasDefault})
+		}
+
+		switch {
+		case !oldField.hasDefault && newField.hasDefault:
+			d.add(&schemaChange{path: fieldPath, change: defaultAddedChange, after: newField.defaultValue, backward: true, forward: true})
+		case oldField.hasDefault && !newField.hasDefault:
+			d.add(&schemaChange{path: fieldPath, change: defaultRemovedChange, before: oldField.defaultValue, backward: true, forward: true})
+		case oldField.defaultValue != newField.defaultValue:
+			d.add(&schemaChange{path: fieldPath, change: defaultChangedChange, before: oldField.defaultValue, after: newField.defaultValue, backward: true, forward: true})
+		}
+
+		d.diff(fieldPath, oldField.typ, newField.typ)
+	}
+
+	for _, newField := range after.fields {
+		if !matched[newField] {
+			d.add(&schemaChange{path: path + "." + newField.name, change: fieldAddedChange, after: newField.typ.String(), backward: newField.hasDefault, forward: true})
+		}
+	}
+}
+
+// findAvroField returns the field of a record with a name or an alias, as a reader resolves the fields of a writer.
+func findAvroField(record *avroType, name string) *avroField {
+	for _, field := range record.fields {
+		if field.name == name {
+			return field
+		}
+	}
+	for _, field := range record.fields {
+		if utils.Contains(field.aliases, name) {
+			return field
+		}
+	}
+	return nil
+}
+
+func (d *avroDiffer) diffEnums(path string, before, after *avroType) {
+	for _, symbol := range before.symbols {
+		if !utils.Contains(after.symbols, symbol) {
+			d.add(&schemaChange{path: path, change: symbolRemovedChange, before: symbol, backward: after.defaultSymbol != "", forward: true})
+		}
+	}
+	for _, symbol := range after.symbols {
+		if !utils.Contains(before.symbols, symbol) {
+			d.add(&schemaChange{path: path, change: symbolAddedChange, after: symbol, backward: true, forward: before.defaultSymbol != ""})
+		}
+	}
+	if before.defaultSymbol != after.defaultSymbol {
+		d.add(&schemaChange{path: path, change: defaultChangedChange, before: before.defaultSymbol, after: after.defaultSymbol, backward: true, forward: true})
+	}
+}
+
+func (d *avroDiffer) diffUnions(path string, before, after *avroType) {
+	for _, oldBranch := range before.branches {
+		if newBranch := findAvroBranch(after, oldBranch); newBranch != nil {
+			d.diff(path, oldBranch, newBranch)
+		} else {
+			d.add(&schemaChange{path: path, change: branchRemovedChange, before: oldBranch.String(), backward: canReadAvro(after, oldBranch), forward: true})
+		}
+	}
+	for _, newBranch := range after.branches {
+		if findAvroBranch(before, newBranch) == nil {
+			d.add(&schemaChange{path: path, change: branchAddedChange, after: newBranch.String(), backward: true, forward: canReadAvro(before, newBranch)})
+		}
+	}
+}
+
+// findAvroBranch returns the branch of a union with the same kind and name as another type.
+// A union may only have one branch of each unnamed kind.
+func findAvroBranch(union, t *avroType) *avroType {
+	for _, branch := range union.branches {
+		if branch.kind == t.kind && shortAvroName(branch.name) == shortAvroName(t.name) {
+			return branch
+		}
+	}
+	return nil
+}
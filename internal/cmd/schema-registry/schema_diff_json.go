@@ -0,0 +1,241 @@
+package schemaregistry
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+)
+
+// jsonSchema is a parsed JSON schema. Only the keywords which affect compatibility are compared.
+type jsonSchema map[string]interface{}
+
+func diffJsonSchemas(before, after *schemaDocument) ([]*schemaChange, error) {
+	beforeSchema, err := parseJsonSchema(before.schema)
+	if err != nil {
+		return nil, err
+	}
+
+	afterSchema, err := parseJsonSchema(after.schema)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*schemaChange
+	diffJsonSchema(&changes, "$", beforeSchema, afterSchema)
+	return changes, nil
+}
+
+func parseJsonSchema(schema string) (jsonSchema, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(schema), &v); err != nil {
+		return nil, errors.Errorf(errors.ParseSchemaErrorMsg, jsonSchemaType, err)
+	}
+	return toJsonSchema(v), nil
+}
+
+// toJsonSchema converts a subschema to an object. The boolean schema "true" accepts anything, like an empty object.
+func toJsonSchema(v interface{}) jsonSchema {
+	if schema, ok := v.(map[string]interface{}); ok {
+		return schema
+	}
+	return jsonSchema{}
+}
+
+// types returns the sorted types allowed by a schema, or nil if any type is allowed.
+func (s jsonSchema) types() []string {
+	var types []string
+	switch typ := s["type"].(type) {
+	case string:
+		types = []string{typ}
+	case []interface{}:
+		for _, t := range typ {
+			if str, ok := t.(string); ok {
+				types = append(types, str)
+			}
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+func (s jsonSchema) properties() map[string]jsonSchema {
+	properties := map[string]jsonSchema{}
+	if m, ok := s["properties"].(map[string]interface{}); ok {
+		for name, property := range m {
+			properties[name] = toJsonSchema(property)
+		}
+	}
+	return properties
+}
+
+func (s jsonSchema) isRequired(property string) bool {
+	required, _ := s["required"].([]interface{})
+	for _, name := range required {
+		if name == property {
+			return true
+		}
+	}
+	return false
+}
+
+// isOpen returns whether an object schema accepts properties which it does not list.
+func (s jsonSchema) isOpen() bool {
+	additionalProperties, ok := s["additionalProperties"].(bool)
+	return !ok || additionalProperties
+}
+
+func (s jsonSchema) String() string {
+	if ref, ok := s["$ref"].(string); ok {
+		return ref
+	}
+	types := s.types()
+	if len(types) == 0 {
+		return "any"
+	}
+	return strings.Join(types, ", ")
+}
+
+// acceptsJsonTypes returns whether a schema allowing the reader's types accepts all values of the writer's types.
+func acceptsJsonTypes(reader, writer []string) bool {
+	if len(reader) == 0 {
+		return true
+	}
+	if len(writer) == 0 {
+		return false
+	}
+	for _, t := range writer {
+		if !utils.Contains(reader, t) && !(t == "integer" && utils.Contains(reader, "number")) {
+			return false
+		}
+	}
+	return true
+}
+
+func diffJsonSchema(changes *[]*schemaChange, path string, before, after jsonSchema) {
+	add := func(change *schemaChange) {
+		*changes = append(*changes, change)
+	}
+
+	beforeRef, _ := before["$ref"].(string)
+	afterRef, _ := after["$ref"].(string)
+	if beforeRef != afterRef {
+		add(&schemaChange{path: path, change: referenceChangedChange, before: beforeRef, after: afterRef})
+		return
+	}
+
+	if beforeTypes, afterTypes := before.types(), after.types(); strings.Join(beforeTypes, ",") != strings.Join(afterTypes, ",") {
+		change := &schemaChange{
+			path:     path,
+			change:   typeChangedChange,
+			before:   before.String(),
+			after:    after.String(),
+			backward: acceptsJsonTypes(afterTypes, beforeTypes),
+			forward:  acceptsJsonTypes(beforeTypes, afterTypes),
+		}
+		if change.backward && !change.forward {
+			change.change = typePromotedChange
+		}
+		add(change)
+	}
+
+	beforeDefault, hasBeforeDefault := before["default"]
+	afterDefault, hasAfterDefault := after["default"]
+	switch {
+	case !hasBeforeDefault && hasAfterDefault:
+		add(&schemaChange{path: path, change: defaultAddedChange, after: marshalJsonValue(afterDefault), backward: true, forward: true})
+	case hasBeforeDefault && !hasAfterDefault:
+		add(&schemaChange{path: path, change: defaultRemovedChange, before: marshalJsonValue(beforeDefault), backward: true, forward: true})
+	case marshalJsonValue(beforeDefault) != marshalJsonValue(afterDefault):
+		add(&schemaChange{path: path, change: defaultChangedChange, before: marshalJsonValue(beforeDefault), after: marshalJsonValue(afterDefault), backward: true, forward: true})
+	}
+
+	diffJsonEnums(changes, path, before, after)
+
+	if before.isOpen() && !after.isOpen() {
+		add(&schemaChange{path: path, change: propertiesClosedChange, before: "true", after: "false", forward: true})
+	} else if !before.isOpen() && after.isOpen() {
+		add(&schemaChange{path: path, change: propertiesOpenedChange, before: "false", after: "true", backward: true})
+	}
+
+	beforeProperties, afterProperties := before.properties(), after.properties()
+	for _, name := range sortedJsonProperties(beforeProperties, afterProperties) {
+		propertyPath := path + "." + name
+		beforeProperty, inBefore := beforeProperties[name]
+		afterProperty, inAfter := afterProperties[name]
+
+		switch {
+		case !inAfter:
+			add(&schemaChange{
+				path:     propertyPath,
+				change:   fieldRemovedChange,
+				before:   beforeProperty.String(),
+				backward: after.isOpen(),
+				forward:  !before.isRequired(name),
+			})
+		case !inBefore:
+			add(&schemaChange{
+				path:     propertyPath,
+				change:   fieldAddedChange,
+				after:    afterProperty.String(),
+				backward: !before.isOpen() && !after.isRequired(name),
+				forward:  before.isOpen(),
+			})
+		default:
+			if !before.isRequired(name) && after.isRequired(name) {
+				add(&schemaChange{path: propertyPath, change: fieldRequiredChange, forward: true})
+			} else if before.isRequired(name) && !after.isRequired(name) {
+				add(&schemaChange{path: propertyPath, change: fieldOptionalChange, backward: true})
+			}
+			diffJsonSchema(changes, propertyPath, beforeProperty, afterProperty)
+		}
+	}
+
+	if beforeItems, ok := before["items"].(map[string]interface{}); ok {
+		if afterItems, ok := after["items"].(map[string]interface{}); ok {
+			diffJsonSchema(changes, path+"[]", beforeItems, afterItems)
+		}
+	}
+}
+
+// diffJsonEnums compares the allowed values of two schemas. Adding a value only widens the schema, and removing one only narrows it.
+func diffJsonEnums(changes *[]*schemaChange, path string, before, after jsonSchema) {
+	beforeValues, _ := before["enum"].([]interface{})
+	afterValues, _ := after["enum"].([]interface{})
+	if beforeValues == nil || afterValues == nil {
+		return
+	}
+
+	beforeSet := make([]string, len(beforeValues))
+	for i, value := range beforeValues {
+		beforeSet[i] = marshalJsonValue(value)
+	}
+	afterSet := make([]string, len(afterValues))
+	for i, value := range afterValues {
+		afterSet[i] = marshalJsonValue(value)
+	}
+
+	for _, value := range beforeSet {
+		if !utils.Contains(afterSet, value) {
+			*changes = append(*changes, &schemaChange{path: path, change: symbolRemovedChange, before: value, forward: true})
+		}
+	}
+	for _, value := range afterSet {
+		if !utils.Contains(beforeSet, value) {
+			*changes = append(*changes, &schemaChange{path: path, change: symbolAddedChange, after: value, backward: true})
+		}
+	}
+}
+
+func sortedJsonProperties(before, after map[string]jsonSchema) []string {
+	names := map[string]bool{}
+	for name := range before {
+		names[name] = true
+	}
+	for name := range after {
+		names[name] = true
+	}
+	return sortedNames(names)
+}
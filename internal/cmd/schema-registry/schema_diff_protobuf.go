@@ -0,0 +1,205 @@
+package schemaregistry
+
+import (
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+
+	"github.com/confluentinc/cli/internal/pkg/errors"
+)
+
+// protobufSchemaFileName is the name under which a schema is parsed, alongside the files of its references.
+const protobufSchemaFileName = "__schema__.proto"
+
+// protobufWireTypes groups the scalar types which are encoded the same way, so that a field may change between them.
+var protobufWireTypes = map[string]string{
+	"int32":    "varint",
+	"uint32":   "varint",
+	"int64":    "varint",
+	"uint64":   "varint",
+	"bool":     "varint",
+	"sint32":   "zigzag",
+	"sint64":   "zigzag",
+	"fixed32":  "fixed32",
+	"sfixed32": "fixed32",
+	"fixed64":  "fixed64",
+	"sfixed64": "fixed64",
+	"string":   "length-delimited",
+	"bytes":    "length-delimited",
+}
+
+func diffProtobufSchemas(before, after *schemaDocument) ([]*schemaChange, error) {
+	beforeFile, err := parseProtobufSchema(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterFile, err := parseProtobufSchema(after)
+	if err != nil {
+		return nil, err
+	}
+
+	beforeMessages, beforeEnums := getProtobufTypes(beforeFile)
+	afterMessages, afterEnums := getProtobufTypes(afterFile)
+
+	// Protobuf readers skip unknown fields and default missing ones, so every change is either compatible in both directions or neither.
+	var changes []*schemaChange
+	add := func(path, change, before, after string, compatible bool) {
+		changes = append(changes, &schemaChange{path: path, change: change, before: before, after: after, backward: compatible, forward: compatible})
+	}
+
+	for _, name := range sortedMessageNames(beforeMessages, afterMessages) {
+		beforeMessage, afterMessage := beforeMessages[name], afterMessages[name]
+		switch {
+		case afterMessage == nil:
+			add(name, messageRemovedChange, name, "", false)
+		case beforeMessage == nil:
+			add(name, messageAddedChange, "", name, true)
+		default:
+			for _, beforeField := range beforeMessage.GetFields() {
+				afterField := afterMessage.FindFieldByNumber(beforeField.GetNumber())
+				if afterField == nil {
+					add(name+"."+beforeField.GetName(), fieldRemovedChange, getProtobufFieldType(beforeField), "", !beforeField.IsRequired())
+					continue
+				}
+
+				path := name + "." + afterField.GetName()
+				if beforeField.GetName() != afterField.GetName() {
+					add(path, fieldRenamedChange, beforeField.GetName(), afterField.GetName(), true)
+				}
+
+				if beforeType, afterType := getProtobufFieldType(beforeField), getProtobufFieldType(afterField); beforeType != afterType {
+					wireType, ok := protobufWireTypes[beforeType]
+					add(path, typeChangedChange, beforeType, afterType, ok && wireType == protobufWireTypes[afterType])
+				}
+
+				if beforeLabel, afterLabel := getProtobufFieldLabel(beforeField), getProtobufFieldLabel(afterField); beforeLabel != afterLabel {
+					add(path, labelChangedChange, beforeLabel, afterLabel, false)
+				}
+
+				beforeDefault, afterDefault := beforeField.AsFieldDescriptorProto().GetDefaultValue(), afterField.AsFieldDescriptorProto().GetDefaultValue()
+				if beforeDefault != afterDefault {
+					add(path, defaultChangedChange, beforeDefault, afterDefault, true)
+				}
+			}
+
+			for _, afterField := range afterMessage.GetFields() {
+				if beforeMessage.FindFieldByNumber(afterField.GetNumber()) == nil {
+					add(name+"."+afterField.GetName(), fieldAddedChange, "", getProtobufFieldType(afterField), !afterField.IsRequired())
+				}
+			}
+		}
+	}
+
+	for _, name := range sortedEnumNames(beforeEnums, afterEnums) {
+		beforeEnum, afterEnum := beforeEnums[name], afterEnums[name]
+		if beforeEnum == nil || afterEnum == nil {
+			continue
+		}
+		for _, value := range beforeEnum.GetValues() {
+			if afterEnum.FindValueByNumber(value.GetNumber()) == nil {
+				add(name, symbolRemovedChange, value.GetName(), "", true)
+			}
+		}
+		for _, value := range afterEnum.GetValues() {
+			if beforeEnum.FindValueByNumber(value.GetNumber()) == nil {
+				add(name, symbolAddedChange, "", value.GetName(), true)
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+func parseProtobufSchema(doc *schemaDocument) (*desc.FileDescriptor, error) {
+	files := map[string]string{protobufSchemaFileName: doc.schema}
+	for name, schema := range doc.references {
+		files[name] = schema
+	}
+
+	parser := protoparse.Parser{Accessor: protoparse.FileContentsFromMap(files)}
+	fileDescriptors, err := parser.ParseFiles(protobufSchemaFileName)
+	if err != nil {
+		return nil, errors.Errorf(errors.ParseSchemaErrorMsg, protobufSchemaType, err)
+	}
+	return fileDescriptors[0], nil
+}
+
+// getProtobufTypes returns the messages and enums defined in a file, including nested ones, keyed by their fully qualified names.
+func getProtobufTypes(file *desc.FileDescriptor) (map[string]*desc.MessageDescriptor, map[string]*desc.EnumDescriptor) {
+	messages := map[string]*desc.MessageDescriptor{}
+	enums := map[string]*desc.EnumDescriptor{}
+
+	var addMessage func(*desc.MessageDescriptor)
+	addMessage = func(message *desc.MessageDescriptor) {
+		// Map fields are represented by synthetic entry messages, which are compared through the type of the field.
+		if message.IsMapEntry() {
+			return
+		}
+		messages[message.GetFullyQualifiedName()] = message
+		for _, nested := range message.GetNestedMessageTypes() {
+			addMessage(nested)
+		}
+		for _, enum := range message.GetNestedEnumTypes() {
+			enums[enum.GetFullyQualifiedName()] = enum
+		}
+	}
+
+	for _, message := range file.GetMessageTypes() {
+		addMessage(message)
+	}
+	for _, enum := range file.GetEnumTypes() {
+		enums[enum.GetFullyQualifiedName()] = enum
+	}
+
+	return messages, enums
+}
+
+func getProtobufFieldType(field *desc.FieldDescriptor) string {
+	if field.IsMap() {
+		return "map<" + getProtobufFieldType(field.GetMapKeyType()) + ", " + getProtobufFieldType(field.GetMapValueType()) + ">"
+	}
+	if message := field.GetMessageType(); message != nil {
+		return message.GetFullyQualifiedName()
+	}
+	if enum := field.GetEnumType(); enum != nil {
+		return enum.GetFullyQualifiedName()
+	}
+	return strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_"))
+}
+
+func getProtobufFieldLabel(field *desc.FieldDescriptor) string {
+	switch {
+	case field.IsMap():
+		return "map"
+	case field.IsRepeated():
+		return "repeated"
+	case field.IsRequired():
+		return "required"
+	default:
+		return "optional"
+	}
+}
+
+func sortedMessageNames(before, after map[string]*desc.MessageDescriptor) []string {
+	names := map[string]bool{}
+	for name := range before {
+		names[name] = true
+	}
+	for name := range after {
+		names[name] = true
+	}
+	return sortedNames(names)
+}
+
+func sortedEnumNames(before, after map[string]*desc.EnumDescriptor) []string {
+	names := map[string]bool{}
+	for name := range before {
+		names[name] = true
+	}
+	for name := range after {
+		names[name] = true
+	}
+	return sortedNames(names)
+}
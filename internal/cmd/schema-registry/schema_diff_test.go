@@ -0,0 +1,154 @@
+package schemaregistry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaChangeIsCompatible(t *testing.T) {
+	req := require.New(t)
+
+	change := &schemaChange{backward: true}
+	req.True(change.isCompatible("BACKWARD"))
+	req.True(change.isCompatible("BACKWARD_TRANSITIVE"))
+	req.False(change.isCompatible("FORWARD"))
+	req.False(change.isCompatible("FULL"))
+	req.True(change.isCompatible("NONE"))
+}
+
+func TestDiffAvroSchemas(t *testing.T) {
+	req := require.New(t)
+
+	before := &schemaDocument{schemaType: avroSchemaType, schema: `{
+		"type": "record",
+		"name": "Payment",
+		"namespace": "io.confluent",
+		"fields": [
+			{"name": "id", "type": "string"},
+			{"name": "amount", "type": "int"},
+			{"name": "currency", "type": "string", "default": "USD"},
+			{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["PENDING", "DONE"]}},
+			{"name": "note", "type": ["null", "string"], "default": null}
+		]
+	}`}
+	after := &schemaDocument{schemaType: avroSchemaType, schema: `{
+		"type": "record",
+		"name": "Payment",
+		"namespace": "io.confluent",
+		"fields": [
+			{"name": "id", "type": "string"},
+			{"name": "amount", "type": "long"},
+			{"name": "currency", "type": "string", "default": "EUR"},
+			{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["PENDING", "DONE", "FAILED"]}},
+			{"name": "region", "type": "string"},
+			{"name": "tags", "type": {"type": "array", "items": "string"}, "default": []}
+		]
+	}`}
+
+	changes, err := diffSchemas(before, after)
+	req.NoError(err)
+	req.Equal([]*schemaChange{
+		{path: "Payment.amount", change: typePromotedChange, before: "int", after: "long", backward: true},
+		{path: "Payment.currency", change: defaultChangedChange, before: `"USD"`, after: `"EUR"`, backward: true, forward: true},
+		{path: "Payment.status", change: symbolAddedChange, after: "FAILED", backward: true},
+		{path: "Payment.note", change: fieldRemovedChange, before: "[null, string]", backward: true, forward: true},
+		{path: "Payment.region", change: fieldAddedChange, after: "string", forward: true},
+		{path: "Payment.tags", change: fieldAddedChange, after: "array<string>", backward: true, forward: true},
+	}, changes)
+
+	changes, err = diffSchemas(after, after)
+	req.NoError(err)
+	req.Empty(changes)
+}
+
+func TestDiffAvroSchemas_Recursive(t *testing.T) {
+	req := require.New(t)
+
+	before := &schemaDocument{schemaType: avroSchemaType, schema: `{"type": "record", "name": "Node", "fields": [{"name": "next", "type": ["null", "Node"], "default": null}, {"name": "value", "type": "float"}]}`}
+	after := &schemaDocument{schemaType: avroSchemaType, schema: `{"type": "record", "name": "Node", "fields": [{"name": "next", "type": ["null", "Node"], "default": null}, {"name": "value", "type": "string"}]}`}
+
+	changes, err := diffSchemas(before, after)
+	req.NoError(err)
+	req.Equal([]*schemaChange{{path: "Node.value", change: typeChangedChange, before: "float", after: "string"}}, changes)
+}
+
+func TestDiffJsonSchemas(t *testing.T) {
+	req := require.New(t)
+
+	before := &schemaDocument{schemaType: jsonSchemaType, schema: `{
+		"type": "object",
+		"properties": {
+			"id": {"type": "string"},
+			"amount": {"type": "integer"},
+			"status": {"type": "string", "enum": ["PENDING", "DONE"]},
+			"note": {"type": "string"}
+		},
+		"required": ["id"],
+		"additionalProperties": false
+	}`}
+	after := &schemaDocument{schemaType: jsonSchemaType, schema: `{
+		"type": "object",
+		"properties": {
+			"id": {"type": "string"},
+			"amount": {"type": "number"},
+			"status": {"type": "string", "enum": ["PENDING", "DONE", "FAILED"]},
+			"region": {"type": "string", "default": "us-west-2"}
+		},
+		"required": ["id", "amount"],
+		"additionalProperties": false
+	}`}
+
+	changes, err := diffSchemas(before, after)
+	req.NoError(err)
+	req.Equal([]*schemaChange{
+		{path: "$.amount", change: fieldRequiredChange, forward: true},
+		{path: "$.amount", change: typePromotedChange, before: "integer", after: "number", backward: true},
+		{path: "$.note", change: fieldRemovedChange, before: "string", forward: true},
+		{path: "$.region", change: fieldAddedChange, after: "string", backward: true},
+		{path: "$.status", change: symbolAddedChange, after: `"FAILED"`, backward: true},
+	}, changes)
+}
+
+func TestDiffProtobufSchemas(t *testing.T) {
+	req := require.New(t)
+
+	before := &schemaDocument{schemaType: protobufSchemaType, schema: `syntax = "proto3";
+package payments;
+import "currency.proto";
+message Payment {
+  string id = 1;
+  int32 amount = 2;
+  string note = 3;
+  Currency currency = 4;
+}
+message Refund {
+  string id = 1;
+}`, references: map[string]string{"currency.proto": `syntax = "proto3"; package payments; enum Currency { USD = 0; }`}}
+	after := &schemaDocument{schemaType: protobufSchemaType, schema: `syntax = "proto3";
+package payments;
+import "currency.proto";
+message Payment {
+  string payment_id = 1;
+  int64 amount = 2;
+  Currency currency = 4;
+  repeated string tags = 5;
+}`, references: before.references}
+
+	changes, err := diffSchemas(before, after)
+	req.NoError(err)
+	req.Equal([]*schemaChange{
+		{path: "payments.Payment.payment_id", change: fieldRenamedChange, before: "id", after: "payment_id", backward: true, forward: true},
+		{path: "payments.Payment.amount", change: typeChangedChange, before: "int32", after: "int64", backward: true, forward: true},
+		{path: "payments.Payment.note", change: fieldRemovedChange, before: "string", backward: true, forward: true},
+		{path: "payments.Payment.tags", change: fieldAddedChange, after: "string", backward: true, forward: true},
+		{path: "payments.Refund", change: messageRemovedChange, before: "payments.Refund"},
+	}, changes)
+}
+
+func TestDiffSchemas_TypeMismatch(t *testing.T) {
+	req := require.New(t)
+
+	_, err := diffSchemas(&schemaDocument{schemaType: avroSchemaType}, &schemaDocument{schemaType: jsonSchemaType})
+	req.EqualError(err, "cannot compare AVRO schema to JSON schema")
+}
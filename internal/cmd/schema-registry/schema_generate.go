@@ -0,0 +1,508 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/google/uuid"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/utils"
+)
+
+// maxGeneratedDepth limits the nesting of generated messages, since recursive types would otherwise never end.
+// Past it, optional values are left empty, and collections have no elements.
+const maxGeneratedDepth = 4
+
+// avroLogicalTypes are the logical types which change the name of a union branch in the JSON encoding of Avro, such as "long.timestamp-millis".
+var avroLogicalTypes = []string{"date", "decimal", "time-millis", "time-micros", "timestamp-millis", "timestamp-micros"}
+
+const generatedAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// SchemaGenerator generates random messages which are valid for a schema,
+// in the JSON format read by the serializers of `confluent kafka topic produce`.
+type SchemaGenerator struct {
+	rand     *rand.Rand
+	now      time.Time
+	generate func() (interface{}, error)
+}
+
+// NewSchemaGenerator returns a generator for a schema, fetching the schemas it references from Schema Registry.
+func NewSchemaGenerator(srClient *srsdk.APIClient, ctx context.Context, schema srsdk.Schema, r *rand.Rand) (*SchemaGenerator, error) {
+	references, err := getSchemaReferences(srClient, ctx, schema.References)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSchemaGenerator(&schemaDocument{schemaType: normalizeSchemaType(schema.SchemaType), schema: schema.Schema, references: references}, r)
+}
+
+func newSchemaGenerator(doc *schemaDocument, r *rand.Rand) (*SchemaGenerator, error) {
+	g := &SchemaGenerator{rand: r, now: time.Now()}
+
+	switch doc.schemaType {
+	case avroSchemaType:
+		t, err := parseAvroSchema(doc)
+		if err != nil {
+			return nil, err
+		}
+		g.generate = func() (interface{}, error) { return g.generateAvro(t, 0), nil }
+	case jsonSchemaType:
+		schema, err := parseJsonSchema(doc.schema)
+		if err != nil {
+			return nil, err
+		}
+		references := map[string]jsonSchema{}
+		for name, reference := range doc.references {
+			references[name], err = parseJsonSchema(reference)
+			if err != nil {
+				return nil, err
+			}
+		}
+		g.generate = func() (interface{}, error) {
+			return g.generateJson(&jsonGeneratorContext{root: schema, references: references}, schema, 0)
+		}
+	case protobufSchemaType:
+		file, err := parseProtobufSchema(doc)
+		if err != nil {
+			return nil, err
+		}
+		// The serializers of `confluent kafka topic produce` always use the first message of the file.
+		messages := file.GetMessageTypes()
+		if len(messages) == 0 {
+			return nil, errors.Errorf(errors.ParseSchemaErrorMsg, protobufSchemaType, "no message types")
+		}
+		g.generate = func() (interface{}, error) {
+			message := g.generateProtobuf(messages[0], 0)
+			out, err := message.MarshalJSON()
+			return json.RawMessage(out), err
+		}
+	default:
+		return nil, errors.Errorf(errors.UnknownSchemaTypeErrorMsg, doc.schemaType)
+	}
+
+	return g, nil
+}
+
+// Generate returns a random message as JSON.
+func (g *SchemaGenerator) Generate() (string, error) {
+	v, err := g.generate()
+	if err != nil {
+		return "", err
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func (g *SchemaGenerator) generateString(minLength, maxLength int) string {
+	length := minLength
+	if maxLength > minLength {
+		length += g.rand.Intn(maxLength - minLength + 1)
+	}
+
+	b := make([]byte, length)
+	for i := range b {
+		b[i] = generatedAlphabet[g.rand.Intn(len(generatedAlphabet))]
+	}
+	return string(b)
+}
+
+func (g *SchemaGenerator) generateUuid() string {
+	id, err := uuid.NewRandomFromReader(g.rand)
+	if err != nil {
+		return uuid.NewString()
+	}
+	return id.String()
+}
+
+// generateTime returns a time within the last day.
+func (g *SchemaGenerator) generateTime() time.Time {
+	return g.now.Add(-time.Duration(g.rand.Int63n(int64(24 * time.Hour))))
+}
+
+func (g *SchemaGenerator) generateAvro(t *avroType, depth int) interface{} {
+	switch t.kind {
+	case "null":
+		return nil
+	case "boolean":
+		return g.rand.Intn(2) == 1
+	case "int":
+		switch t.logicalType {
+		case "date":
+			return g.generateTime().Unix() / (24 * 60 * 60)
+		case "time-millis":
+			return g.rand.Int63n(24 * time.Hour.Milliseconds())
+		}
+		return g.rand.Int31n(1000)
+	case "long":
+		switch t.logicalType {
+		case "time-micros":
+			return g.rand.Int63n(24 * time.Hour.Microseconds())
+		case "timestamp-millis", "local-timestamp-millis":
+			return g.generateTime().UnixMilli()
+		case "timestamp-micros", "local-timestamp-micros":
+			return g.generateTime().UnixMicro()
+		}
+		return g.rand.Int63n(100000)
+	case "float", "double":
+		return math.Round(g.rand.Float64()*100000) / 100
+	case "bytes":
+		if t.logicalType == "decimal" {
+			// A single byte below 100 is a valid unscaled value for any precision of at least 2.
+			return string([]byte{byte(g.rand.Intn(100))})
+		}
+		return g.generateString(4, 12)
+	case "string":
+		if t.logicalType == "uuid" {
+			return g.generateUuid()
+		}
+		return g.generateString(4, 12)
+	case "record":
+		record := make(map[string]interface{}, len(t.fields))
+		for _, field := range t.fields {
+			record[field.name] = g.generateAvro(field.typ, depth+1)
+		}
+		return record
+	case "enum":
+		if len(t.symbols) == 0 {
+			return ""
+		}
+		return t.symbols[g.rand.Intn(len(t.symbols))]
+	case "fixed":
+		return g.generateString(t.size, t.size)
+	case "array":
+		items := []interface{}{}
+		for i, n := 0, g.generateCollectionSize(depth); i < n; i++ {
+			items = append(items, g.generateAvro(t.items, depth+1))
+		}
+		return items
+	case "map":
+		values := map[string]interface{}{}
+		for i, n := 0, g.generateCollectionSize(depth); i < n; i++ {
+			values[g.generateString(4, 8)] = g.generateAvro(t.items, depth+1)
+		}
+		return values
+	case "union":
+		if len(t.branches) == 0 {
+			return nil
+		}
+		branch := g.chooseAvroBranch(t.branches, depth)
+		if branch.kind == "null" {
+			return nil
+		}
+		// In the JSON encoding of Avro, values of unions are wrapped in an object keyed by the name of the branch.
+		return map[string]interface{}{getAvroBranchName(branch): g.generateAvro(branch, depth+1)}
+	default:
+		return nil
+	}
+}
+
+// chooseAvroBranch picks a random branch of a union, preferring "null" once the maximum depth is reached.
+func (g *SchemaGenerator) chooseAvroBranch(branches []*avroType, depth int) *avroType {
+	if depth >= maxGeneratedDepth {
+		for _, branch := range branches {
+			if branch.kind == "null" {
+				return branch
+			}
+		}
+	}
+	return branches[g.rand.Intn(len(branches))]
+}
+
+func getAvroBranchName(t *avroType) string {
+	switch t.kind {
+	case "record", "enum", "fixed":
+		return t.name
+	}
+	for _, logicalType := range avroLogicalTypes {
+		if t.logicalType == logicalType {
+			return t.kind + "." + t.logicalType
+		}
+	}
+	return t.kind
+}
+
+// generateCollectionSize returns the number of elements of a generated array or map.
+func (g *SchemaGenerator) generateCollectionSize(depth int) int {
+	if depth >= maxGeneratedDepth {
+		return 0
+	}
+	return g.rand.Intn(4)
+}
+
+func (g *SchemaGenerator) generateProtobuf(message *desc.MessageDescriptor, depth int) *dynamic.Message {
+	m := dynamic.NewMessage(message)
+
+	// Only one field of each oneof may be set.
+	for _, oneOf := range message.GetOneOfs() {
+		if oneOf.IsSynthetic() {
+			continue
+		}
+		choices := oneOf.GetChoices()
+		field := choices[g.rand.Intn(len(choices))]
+		if field.GetMessageType() == nil || depth < maxGeneratedDepth {
+			m.SetField(field, g.generateProtobufValue(field, depth))
+		}
+	}
+
+	for _, field := range message.GetFields() {
+		if oneOf := field.GetOneOf(); oneOf != nil && !oneOf.IsSynthetic() {
+			continue
+		}
+
+		switch {
+		case field.IsMap():
+			for i, n := 0, g.generateCollectionSize(depth); i < n; i++ {
+				m.PutMapField(field, g.generateProtobufScalar(field.GetMapKeyType(), depth), g.generateProtobufValue(field.GetMapValueType(), depth))
+			}
+		case field.IsRepeated():
+			for i, n := 0, g.generateCollectionSize(depth); i < n; i++ {
+				m.AddRepeatedField(field, g.generateProtobufValue(field, depth))
+			}
+		case field.GetMessageType() != nil && depth >= maxGeneratedDepth:
+			continue
+		default:
+			m.SetField(field, g.generateProtobufValue(field, depth))
+		}
+	}
+
+	return m
+}
+
+func (g *SchemaGenerator) generateProtobufValue(field *desc.FieldDescriptor, depth int) interface{} {
+	if message := field.GetMessageType(); message != nil {
+		return g.generateProtobuf(message, depth+1)
+	}
+	return g.generateProtobufScalar(field, depth)
+}
+
+func (g *SchemaGenerator) generateProtobufScalar(field *desc.FieldDescriptor, depth int) interface{} {
+	switch field.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		return g.rand.Intn(2) == 1
+	case descriptorpb.FieldDescriptorProto_TYPE_INT32, descriptorpb.FieldDescriptorProto_TYPE_SINT32, descriptorpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return g.rand.Int31n(1000)
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT32, descriptorpb.FieldDescriptorProto_TYPE_FIXED32:
+		return uint32(g.rand.Int31n(1000))
+	case descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_TYPE_SINT64, descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+		return g.rand.Int63n(100000)
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT64, descriptorpb.FieldDescriptorProto_TYPE_FIXED64:
+		return uint64(g.rand.Int63n(100000))
+	case descriptorpb.FieldDescriptorProto_TYPE_FLOAT:
+		return float32(math.Round(g.rand.Float64()*100000) / 100)
+	case descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return math.Round(g.rand.Float64()*100000) / 100
+	case descriptorpb.FieldDescriptorProto_TYPE_STRING:
+		return g.generateString(4, 12)
+	case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		return []byte(g.generateString(4, 12))
+	case descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		values := field.GetEnumType().GetValues()
+		return values[g.rand.Intn(len(values))].GetNumber()
+	default:
+		return g.generateProtobuf(field.GetMessageType(), depth+1)
+	}
+}
+
+// jsonGeneratorContext holds the documents against which references are resolved while generating a value for a JSON schema.
+type jsonGeneratorContext struct {
+	root       jsonSchema
+	references map[string]jsonSchema
+}
+
+func (g *SchemaGenerator) generateJson(c *jsonGeneratorContext, schema jsonSchema, depth int) (interface{}, error) {
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved, root, err := c.resolve(ref)
+		if err != nil {
+			return nil, err
+		}
+		return g.generateJson(&jsonGeneratorContext{root: root, references: c.references}, resolved, depth)
+	}
+
+	if values, ok := schema["enum"].([]interface{}); ok && len(values) > 0 {
+		return values[g.rand.Intn(len(values))], nil
+	}
+	if value, ok := schema["const"]; ok {
+		return value, nil
+	}
+
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		if subschemas, ok := schema[keyword].([]interface{}); ok && len(subschemas) > 0 {
+			return g.generateJson(c, toJsonSchema(subschemas[g.rand.Intn(len(subschemas))]), depth)
+		}
+	}
+
+	if subschemas, ok := schema["allOf"].([]interface{}); ok && len(subschemas) > 0 {
+		merged := map[string]interface{}{}
+		for _, subschema := range subschemas {
+			v, err := g.generateJson(c, toJsonSchema(subschema), depth)
+			if err != nil {
+				return nil, err
+			}
+			object, ok := v.(map[string]interface{})
+			if !ok {
+				return v, nil
+			}
+			for key, value := range object {
+				merged[key] = value
+			}
+		}
+		return merged, nil
+	}
+
+	types := schema.types()
+	if types == nil {
+		types = []string{"object"}
+		if _, ok := schema["properties"]; !ok {
+			types = []string{"string"}
+		}
+	}
+	typ := types[g.rand.Intn(len(types))]
+	if depth >= maxGeneratedDepth && utils.Contains(types, "null") {
+		typ = "null"
+	}
+
+	switch typ {
+	case "null":
+		return nil, nil
+	case "boolean":
+		return g.rand.Intn(2) == 1, nil
+	case "integer":
+		minimum, maximum := getJsonRange(schema, 0, 1000)
+		lower, upper := int64(math.Ceil(minimum)), int64(math.Floor(maximum))
+		if upper < lower {
+			upper = lower
+		}
+		return lower + g.rand.Int63n(upper-lower+1), nil
+	case "number":
+		minimum, maximum := getJsonRange(schema, 0, 1000)
+		return minimum + math.Round(g.rand.Float64()*(maximum-minimum)*100)/100, nil
+	case "string":
+		return g.generateJsonString(schema), nil
+	case "array":
+		minItems, maxItems := getJsonInt(schema, "minItems", 0), getJsonInt(schema, "maxItems", 3)
+		if depth >= maxGeneratedDepth || maxItems < minItems {
+			maxItems = minItems
+		}
+		items := []interface{}{}
+		for i, n := 0, minItems+g.rand.Intn(maxItems-minItems+1); i < n; i++ {
+			item, err := g.generateJson(c, toJsonSchema(schema["items"]), depth+1)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	default:
+		object := map[string]interface{}{}
+		for _, name := range sortedJsonProperties(schema.properties(), nil) {
+			// Optional properties are included half of the time, until the maximum depth is reached.
+			if !schema.isRequired(name) && (depth >= maxGeneratedDepth || g.rand.Intn(2) == 0) {
+				continue
+			}
+			value, err := g.generateJson(c, schema.properties()[name], depth+1)
+			if err != nil {
+				return nil, err
+			}
+			object[name] = value
+		}
+		return object, nil
+	}
+}
+
+func (g *SchemaGenerator) generateJsonString(schema jsonSchema) string {
+	format, _ := schema["format"].(string)
+	switch format {
+	case "date-time":
+		return g.generateTime().UTC().Format(time.RFC3339)
+	case "date":
+		return g.generateTime().UTC().Format("2006-01-02")
+	case "time":
+		return g.generateTime().UTC().Format("15:04:05Z")
+	case "email":
+		return strings.ToLower(g.generateString(4, 8)) + "@example.com"
+	case "uri":
+		return "https://example.com/" + strings.ToLower(g.generateString(4, 8))
+	case "uuid":
+		return g.generateUuid()
+	}
+
+	minLength := getJsonInt(schema, "minLength", 4)
+	maxLength := getJsonInt(schema, "maxLength", minLength+8)
+	if maxLength < minLength {
+		minLength = maxLength
+	}
+	return g.generateString(minLength, maxLength)
+}
+
+// resolve returns the subschema a reference points to, and the document it belongs to.
+// References are either local JSON pointers, such as "#/definitions/Address", or the names of referenced schemas.
+func (c *jsonGeneratorContext) resolve(ref string) (jsonSchema, jsonSchema, error) {
+	name, pointer, _ := strings.Cut(ref, "#")
+
+	root := c.root
+	if name != "" {
+		var ok bool
+		root, ok = c.references[strings.TrimPrefix(name, "/")]
+		if !ok {
+			return nil, nil, errors.Errorf(errors.ParseSchemaErrorMsg, jsonSchemaType, fmt.Sprintf(`unresolved reference "%s"`, ref))
+		}
+	}
+
+	var v interface{} = map[string]interface{}(root)
+	for _, token := range strings.Split(strings.Trim(pointer, "/"), "/") {
+		if token == "" {
+			continue
+		}
+		object, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, nil, errors.Errorf(errors.ParseSchemaErrorMsg, jsonSchemaType, fmt.Sprintf(`unresolved reference "%s"`, ref))
+		}
+		v = object[strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")]
+	}
+
+	return toJsonSchema(v), root, nil
+}
+
+// getJsonRange returns the range of a numeric schema, made inclusive.
+func getJsonRange(schema jsonSchema, minimum, maximum float64) (float64, float64) {
+	if value, ok := schema["minimum"].(float64); ok {
+		minimum = value
+	} else if value, ok := schema["exclusiveMinimum"].(float64); ok {
+		minimum = value + 1
+	}
+	if value, ok := schema["maximum"].(float64); ok {
+		maximum = value
+	} else if value, ok := schema["exclusiveMaximum"].(float64); ok {
+		maximum = value - 1
+	}
+
+	if maximum < minimum {
+		if _, ok := schema["maximum"]; ok {
+			minimum = maximum
+		} else {
+			maximum = minimum + 1000
+		}
+	}
+	return minimum, maximum
+}
+
+func getJsonInt(schema jsonSchema, keyword string, defaultValue int) int {
+	if value, ok := schema[keyword].(float64); ok {
+		return int(value)
+	}
+	return defaultValue
+}
@@ -0,0 +1,141 @@
+package schemaregistry
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+func TestGenerateAvro(t *testing.T) {
+	req := require.New(t)
+
+	schema := `{
+		"type": "record",
+		"name": "Order",
+		"namespace": "io.confluent",
+		"fields": [
+			{"name": "id", "type": {"type": "string", "logicalType": "uuid"}},
+			{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["OPEN", "CLOSED"]}},
+			{"name": "created_at", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+			{"name": "shipped_on", "type": ["null", {"type": "int", "logicalType": "date"}], "default": null},
+			{"name": "total", "type": ["null", {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}]},
+			{"name": "code", "type": {"type": "fixed", "name": "Code", "size": 4}},
+			{"name": "tags", "type": {"type": "map", "values": "string"}},
+			{"name": "parent", "type": ["null", "Order"]},
+			{"name": "items", "type": {"type": "array", "items": {"type": "record", "name": "Item", "fields": [{"name": "sku", "type": ["null", "string"]}]}}}
+		]
+	}`
+
+	codec, err := goavro.NewCodec(schema)
+	req.NoError(err)
+
+	g, err := newSchemaGenerator(&schemaDocument{schemaType: avroSchemaType, schema: schema}, rand.New(rand.NewSource(1)))
+	req.NoError(err)
+
+	for i := 0; i < 100; i++ {
+		message, err := g.Generate()
+		req.NoError(err)
+		_, _, err = codec.NativeFromTextual([]byte(message))
+		req.NoError(err, message)
+	}
+}
+
+func TestGenerateJson(t *testing.T) {
+	req := require.New(t)
+
+	schema := `{
+		"type": "object",
+		"properties": {
+			"id": {"type": "string", "format": "uuid"},
+			"email": {"type": "string", "format": "email"},
+			"created_at": {"type": "string", "format": "date-time"},
+			"code": {"type": "string", "minLength": 2, "maxLength": 2},
+			"quantity": {"type": "integer", "minimum": 1, "maximum": 5},
+			"price": {"type": "number", "exclusiveMinimum": 0},
+			"status": {"enum": ["OPEN", "CLOSED"]},
+			"note": {"type": ["null", "string"]},
+			"customer": {"$ref": "customer.json"},
+			"items": {"type": "array", "minItems": 1, "items": {"$ref": "#/definitions/Item"}}
+		},
+		"required": ["id", "status", "items"],
+		"additionalProperties": false,
+		"definitions": {
+			"Item": {
+				"type": "object",
+				"properties": {"sku": {"type": "string"}},
+				"required": ["sku"],
+				"additionalProperties": false
+			}
+		}
+	}`
+	customer := `{"type": "object", "properties": {"name": {"type": "string"}}, "required": ["name"]}`
+
+	loader := gojsonschema.NewSchemaLoader()
+	req.NoError(loader.AddSchema("/customer.json", gojsonschema.NewStringLoader(customer)))
+	validator, err := loader.Compile(gojsonschema.NewStringLoader(schema))
+	req.NoError(err)
+
+	g, err := newSchemaGenerator(&schemaDocument{schemaType: jsonSchemaType, schema: schema, references: map[string]string{"customer.json": customer}}, rand.New(rand.NewSource(1)))
+	req.NoError(err)
+
+	for i := 0; i < 100; i++ {
+		message, err := g.Generate()
+		req.NoError(err)
+		result, err := validator.Validate(gojsonschema.NewStringLoader(message))
+		req.NoError(err)
+		req.True(result.Valid(), "%s: %v", message, result.Errors())
+	}
+}
+
+func TestGenerateProtobuf(t *testing.T) {
+	req := require.New(t)
+
+	schema := `syntax = "proto3";
+import "customer.proto";
+
+message Order {
+  string id = 1;
+  Status status = 2;
+  repeated Item items = 3;
+  map<string, int64> tags = 4;
+  Customer customer = 5;
+  oneof payment {
+    string card = 6;
+    bytes token = 7;
+  }
+  Order parent = 8;
+
+  message Item {
+    string sku = 1;
+    double price = 2;
+  }
+}
+
+enum Status {
+  OPEN = 0;
+  CLOSED = 1;
+}
+`
+	customer := `syntax = "proto3";
+message Customer {
+  string name = 1;
+}
+`
+	doc := &schemaDocument{schemaType: protobufSchemaType, schema: schema, references: map[string]string{"customer.proto": customer}}
+
+	file, err := parseProtobufSchema(doc)
+	req.NoError(err)
+
+	g, err := newSchemaGenerator(doc, rand.New(rand.NewSource(1)))
+	req.NoError(err)
+
+	for i := 0; i < 100; i++ {
+		message, err := g.Generate()
+		req.NoError(err)
+		req.NoError(dynamic.NewMessage(file.GetMessageTypes()[0]).UnmarshalJSON([]byte(message)), message)
+	}
+}
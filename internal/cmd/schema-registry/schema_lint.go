@@ -0,0 +1,340 @@
+package schemaregistry
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-yaml/yaml"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/linkedin/goavro/v2"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/confluentinc/cli/internal/pkg/errors"
+)
+
+const (
+	docsLintRule     = "docs"
+	defaultsLintRule = "defaults"
+	namingLintRule   = "naming"
+)
+
+// namingConventions maps each naming convention which may be configured in a rules file to the pattern of valid names.
+var namingConventions = map[string]*regexp.Regexp{
+	"PascalCase":       regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`),
+	"camelCase":        regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+	"snake_case":       regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`),
+	"UPPER_SNAKE_CASE": regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`),
+}
+
+// schemaLintRules configures the checks of the linter. Documentation and defaults are required unless disabled,
+// and names are only checked against the conventions which are set.
+type schemaLintRules struct {
+	RequireDocs     *bool             `yaml:"require_docs"`
+	RequireDefaults *bool             `yaml:"require_defaults"`
+	Naming          schemaNamingRules `yaml:"naming"`
+}
+
+type schemaNamingRules struct {
+	Types       string `yaml:"types"`
+	Fields      string `yaml:"fields"`
+	EnumSymbols string `yaml:"enum_symbols"`
+}
+
+type schemaLintIssue struct {
+	path    string
+	rule    string
+	message string
+}
+
+type schemaLinter struct {
+	rules  *schemaLintRules
+	issues []*schemaLintIssue
+}
+
+func readSchemaLintRules(file string) (*schemaLintRules, error) {
+	rules := new(schemaLintRules)
+	if file == "" {
+		return rules, nil
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is a subset of YAML, so both formats are read the same way.
+	if err := yaml.UnmarshalStrict(data, rules); err != nil {
+		return nil, errors.Errorf(errors.InvalidSchemaLintRulesErrorMsg, file, err)
+	}
+
+	for _, convention := range []string{rules.Naming.Types, rules.Naming.Fields, rules.Naming.EnumSymbols} {
+		if _, ok := namingConventions[convention]; convention != "" && !ok {
+			return nil, errors.Errorf(errors.InvalidSchemaLintRulesErrorMsg, file, fmt.Sprintf(`unknown naming convention "%s"`, convention))
+		}
+	}
+
+	return rules, nil
+}
+
+// readSchemaLintReferences reads the local files referenced by a schema, keyed by their names.
+// The files in a directory are named by their paths relative to the directory, and other files by their base names.
+func readSchemaLintReferences(paths []string) (map[string]string, error) {
+	references := map[string]string{}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+			references[filepath.Base(path)] = string(data)
+			continue
+		}
+
+		err = filepath.WalkDir(path, func(file string, entry os.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return err
+			}
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			name, err := filepath.Rel(path, file)
+			if err != nil {
+				return err
+			}
+			references[filepath.ToSlash(name)] = string(data)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return references, nil
+}
+
+// lintSchema parses a local schema file and returns the issues found, or an error if the schema is invalid.
+// Protobuf imports which are not references are looked up relative to the directory of the file.
+func lintSchema(doc *schemaDocument, file string, rules *schemaLintRules) ([]*schemaLintIssue, error) {
+	l := &schemaLinter{rules: rules}
+
+	switch doc.schemaType {
+	case avroSchemaType:
+		if err := l.lintAvro(doc); err != nil {
+			return nil, err
+		}
+	case jsonSchemaType:
+		if err := l.lintJson(doc); err != nil {
+			return nil, err
+		}
+	case protobufSchemaType:
+		if err := l.lintProtobuf(doc, file); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Errorf(errors.UnknownSchemaTypeErrorMsg, doc.schemaType)
+	}
+
+	return l.issues, nil
+}
+
+func (l *schemaLinter) add(path, rule, message string) {
+	l.issues = append(l.issues, &schemaLintIssue{path: path, rule: rule, message: message})
+}
+
+func (l *schemaLinter) checkDoc(path, kind, doc string) {
+	if (l.rules.RequireDocs == nil || *l.rules.RequireDocs) && strings.TrimSpace(doc) == "" {
+		l.add(path, docsLintRule, fmt.Sprintf("%s has no documentation", kind))
+	}
+}
+
+func (l *schemaLinter) checkName(path, kind, name, convention string) {
+	if re, ok := namingConventions[convention]; ok && !re.MatchString(name) {
+		l.add(path, namingLintRule, fmt.Sprintf(`%s name "%s" is not %s`, kind, name, convention))
+	}
+}
+
+func (l *schemaLinter) lintAvro(doc *schemaDocument) error {
+	// The codec cannot resolve named types from other files, so only standalone schemas are validated by it.
+	if len(doc.references) == 0 {
+		if _, err := goavro.NewCodec(doc.schema); err != nil {
+			return errors.Errorf(errors.ParseSchemaErrorMsg, avroSchemaType, err)
+		}
+	}
+
+	t, err := parseAvroSchema(doc)
+	if err != nil {
+		return err
+	}
+
+	l.lintAvroType(t, map[string]bool{})
+	return nil
+}
+
+func (l *schemaLinter) lintAvroType(t *avroType, visited map[string]bool) {
+	switch t.kind {
+	case "record", "enum", "fixed":
+		if visited[t.name] {
+			return
+		}
+		visited[t.name] = true
+	}
+
+	name := shortAvroName(t.name)
+	switch t.kind {
+	case "record":
+		l.checkDoc(name, "record", t.doc)
+		l.checkName(name, "record", name, l.rules.Naming.Types)
+		for _, field := range t.fields {
+			path := name + "." + field.name
+			l.checkDoc(path, "field", field.doc)
+			l.checkName(path, "field", field.name, l.rules.Naming.Fields)
+			if (l.rules.RequireDefaults == nil || *l.rules.RequireDefaults) && !field.hasDefault && !isNullableAvro(field.typ) {
+				l.add(path, defaultsLintRule, "field is not nullable and has no default")
+			}
+			l.lintAvroType(field.typ, visited)
+		}
+	case "enum":
+		l.checkDoc(name, "enum", t.doc)
+		l.checkName(name, "enum", name, l.rules.Naming.Types)
+		for _, symbol := range t.symbols {
+			l.checkName(name+"."+symbol, "symbol", symbol, l.rules.Naming.EnumSymbols)
+		}
+	case "fixed":
+		l.checkName(name, "fixed", name, l.rules.Naming.Types)
+	case "array", "map":
+		l.lintAvroType(t.items, visited)
+	case "union":
+		for _, branch := range t.branches {
+			l.lintAvroType(branch, visited)
+		}
+	}
+}
+
+func isNullableAvro(t *avroType) bool {
+	if t.kind == "union" {
+		for _, branch := range t.branches {
+			if branch.kind == "null" {
+				return true
+			}
+		}
+	}
+	return t.kind == "null"
+}
+
+func (l *schemaLinter) lintJson(doc *schemaDocument) error {
+	loader := gojsonschema.NewSchemaLoader()
+	for _, name := range sortedReferenceNames(doc.references) {
+		if err := loader.AddSchema("/"+name, gojsonschema.NewStringLoader(doc.references[name])); err != nil {
+			return errors.Errorf(errors.ParseSchemaErrorMsg, jsonSchemaType, err)
+		}
+	}
+	if _, err := loader.Compile(gojsonschema.NewStringLoader(doc.schema)); err != nil {
+		return errors.Errorf(errors.ParseSchemaErrorMsg, jsonSchemaType, err)
+	}
+
+	schema, err := parseJsonSchema(doc.schema)
+	if err != nil {
+		return err
+	}
+
+	l.checkDoc("$", "schema", jsonDescription(schema))
+	l.lintJsonSchema("$", schema)
+	return nil
+}
+
+func (l *schemaLinter) lintJsonSchema(path string, schema jsonSchema) {
+	for _, keyword := range []string{"definitions", "$defs"} {
+		definitions, _ := schema[keyword].(map[string]interface{})
+		names := make([]string, 0, len(definitions))
+		for name := range definitions {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			definitionPath := path + "." + keyword + "." + name
+			definition := toJsonSchema(definitions[name])
+			l.checkDoc(definitionPath, "definition", jsonDescription(definition))
+			l.checkName(definitionPath, "definition", name, l.rules.Naming.Types)
+			l.lintJsonSchema(definitionPath, definition)
+		}
+	}
+
+	properties := schema.properties()
+	for _, name := range sortedJsonProperties(properties, nil) {
+		propertyPath := path + "." + name
+		l.checkDoc(propertyPath, "property", jsonDescription(properties[name]))
+		l.checkName(propertyPath, "property", name, l.rules.Naming.Fields)
+		l.lintJsonSchema(propertyPath, properties[name])
+	}
+
+	if items, ok := schema["items"]; ok {
+		l.lintJsonSchema(path+"[]", toJsonSchema(items))
+	}
+}
+
+func jsonDescription(schema jsonSchema) string {
+	description, _ := schema["description"].(string)
+	return description
+}
+
+func (l *schemaLinter) lintProtobuf(doc *schemaDocument, file string) error {
+	fileName := filepath.Base(file)
+	parser := protoparse.Parser{
+		Accessor: func(name string) (io.ReadCloser, error) {
+			if name == fileName {
+				return io.NopCloser(strings.NewReader(doc.schema)), nil
+			}
+			if reference, ok := doc.references[name]; ok {
+				return io.NopCloser(strings.NewReader(reference)), nil
+			}
+			return os.Open(filepath.Join(filepath.Dir(file), name))
+		},
+		IncludeSourceCodeInfo: true,
+	}
+	fileDescriptors, err := parser.ParseFiles(fileName)
+	if err != nil {
+		return errors.Errorf(errors.ParseSchemaErrorMsg, protobufSchemaType, err)
+	}
+
+	messages, enums := getProtobufTypes(fileDescriptors[0])
+
+	for _, name := range sortedMessageNames(messages, nil) {
+		message := messages[name]
+		l.checkDoc(name, "message", getProtobufComments(message))
+		l.checkName(name, "message", message.GetName(), l.rules.Naming.Types)
+		for _, field := range message.GetFields() {
+			path := name + "." + field.GetName()
+			l.checkDoc(path, "field", getProtobufComments(field))
+			l.checkName(path, "field", field.GetName(), l.rules.Naming.Fields)
+		}
+	}
+
+	for _, name := range sortedEnumNames(enums, nil) {
+		enum := enums[name]
+		l.checkDoc(name, "enum", getProtobufComments(enum))
+		l.checkName(name, "enum", enum.GetName(), l.rules.Naming.Types)
+		for _, value := range enum.GetValues() {
+			l.checkName(name+"."+value.GetName(), "symbol", value.GetName(), l.rules.Naming.EnumSymbols)
+		}
+	}
+
+	return nil
+}
+
+// getProtobufComments returns the comments before or after the declaration of a message, enum, or field.
+func getProtobufComments(descriptor desc.Descriptor) string {
+	info := descriptor.GetSourceInfo()
+	return info.GetLeadingComments() + info.GetTrailingComments()
+}
@@ -0,0 +1,139 @@
+package schemaregistry
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func lintIssueStrings(issues []*schemaLintIssue) []string {
+	out := make([]string, len(issues))
+	for i, issue := range issues {
+		out[i] = issue.path + " " + issue.rule + ": " + issue.message
+	}
+	return out
+}
+
+func TestLintAvroSchema(t *testing.T) {
+	req := require.New(t)
+
+	schema := `{
+		"type": "record",
+		"name": "Order",
+		"doc": "An order.",
+		"fields": [
+			{"name": "order_id", "type": "string", "doc": "The ID of the order."},
+			{"name": "CustomerId", "type": ["null", "string"], "default": null, "doc": "The ID of the customer."},
+			{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["OPEN", "closed"]}}
+		]
+	}`
+	rules := &schemaLintRules{Naming: schemaNamingRules{Types: "PascalCase", Fields: "snake_case", EnumSymbols: "UPPER_SNAKE_CASE"}}
+
+	issues, err := lintSchema(&schemaDocument{schemaType: avroSchemaType, schema: schema}, "order.avsc", rules)
+	req.NoError(err)
+	req.Equal([]string{
+		`Order.order_id defaults: field is not nullable and has no default`,
+		`Order.CustomerId naming: field name "CustomerId" is not snake_case`,
+		`Order.status docs: field has no documentation`,
+		`Order.status defaults: field is not nullable and has no default`,
+		`Status docs: enum has no documentation`,
+		`Status.closed naming: symbol name "closed" is not UPPER_SNAKE_CASE`,
+	}, lintIssueStrings(issues))
+
+	disabled := false
+	issues, err = lintSchema(&schemaDocument{schemaType: avroSchemaType, schema: schema}, "order.avsc", &schemaLintRules{RequireDocs: &disabled, RequireDefaults: &disabled})
+	req.NoError(err)
+	req.Empty(issues)
+
+	_, err = lintSchema(&schemaDocument{schemaType: avroSchemaType, schema: `{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "uuid"}]}`}, "order.avsc", rules)
+	req.Error(err)
+}
+
+func TestLintJsonSchema(t *testing.T) {
+	req := require.New(t)
+
+	schema := `{
+		"description": "An order.",
+		"type": "object",
+		"properties": {
+			"orderId": {"type": "string", "description": "The ID of the order."},
+			"items": {"type": "array", "items": {"$ref": "#/definitions/line_item"}}
+		},
+		"definitions": {
+			"line_item": {"type": "object", "description": "An item.", "properties": {"sku": {"type": "string", "description": "The SKU."}}}
+		}
+	}`
+	rules := &schemaLintRules{Naming: schemaNamingRules{Types: "PascalCase", Fields: "camelCase"}}
+
+	issues, err := lintSchema(&schemaDocument{schemaType: jsonSchemaType, schema: schema}, "order.json", rules)
+	req.NoError(err)
+	req.Equal([]string{
+		`$.definitions.line_item naming: definition name "line_item" is not PascalCase`,
+		`$.items docs: property has no documentation`,
+	}, lintIssueStrings(issues))
+
+	_, err = lintSchema(&schemaDocument{schemaType: jsonSchemaType, schema: `{"type": 1}`}, "order.json", rules)
+	req.Error(err)
+}
+
+func TestLintProtobufSchema(t *testing.T) {
+	req := require.New(t)
+
+	dir := t.TempDir()
+	req.NoError(os.WriteFile(filepath.Join(dir, "customer.proto"), []byte(`syntax = "proto3";
+// A customer.
+message Customer {
+  // The ID of the customer.
+  string id = 1;
+}
+`), 0644))
+
+	schema := `syntax = "proto3";
+import "customer.proto";
+
+// An order.
+message Order {
+  string order_id = 1; // The ID of the order.
+  Customer customer = 2;
+  status Status = 3;
+}
+
+enum status {
+  OPEN = 0;
+  closed = 1;
+}
+`
+	rules := &schemaLintRules{Naming: schemaNamingRules{Types: "PascalCase", Fields: "snake_case", EnumSymbols: "UPPER_SNAKE_CASE"}}
+
+	issues, err := lintSchema(&schemaDocument{schemaType: protobufSchemaType, schema: schema}, filepath.Join(dir, "order.proto"), rules)
+	req.NoError(err)
+	req.Equal([]string{
+		`Order.customer docs: field has no documentation`,
+		`Order.Status docs: field has no documentation`,
+		`Order.Status naming: field name "Status" is not snake_case`,
+		`status docs: enum has no documentation`,
+		`status naming: enum name "status" is not PascalCase`,
+		`status.closed naming: symbol name "closed" is not UPPER_SNAKE_CASE`,
+	}, lintIssueStrings(issues))
+
+	_, err = lintSchema(&schemaDocument{schemaType: protobufSchemaType, schema: `message Order {`}, filepath.Join(dir, "order.proto"), rules)
+	req.Error(err)
+}
+
+func TestReadSchemaLintRules(t *testing.T) {
+	req := require.New(t)
+
+	file := filepath.Join(t.TempDir(), "rules.yaml")
+	req.NoError(os.WriteFile(file, []byte("require_docs: false\nnaming:\n  fields: snake_case\n"), 0644))
+	rules, err := readSchemaLintRules(file)
+	req.NoError(err)
+	req.False(*rules.RequireDocs)
+	req.Nil(rules.RequireDefaults)
+	req.Equal("snake_case", rules.Naming.Fields)
+
+	req.NoError(os.WriteFile(file, []byte("naming:\n  fields: kebab-case\n"), 0644))
+	_, err = readSchemaLintRules(file)
+	req.EqualError(err, `invalid lint rules file "`+file+`": unknown naming convention "kebab-case"`)
+}
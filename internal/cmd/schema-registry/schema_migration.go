@@ -0,0 +1,352 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/internal/pkg/errors"
+	"github.com/confluentinc/cli/internal/pkg/output"
+)
+
+// An export is a directory with the top-level settings in "config.json", and a directory for each subject in "subjects",
+// named after the escaped subject, with the subject-level settings in "config.json" and each version in "versions/<version>.json".
+const (
+	exportConfigFileName  = "config.json"
+	exportSubjectsDirName = "subjects"
+	exportVersionsDirName = "versions"
+	importMode            = "IMPORT"
+)
+
+type exportedConfig struct {
+	Compatibility string `json:"compatibility,omitempty"`
+	Mode          string `json:"mode,omitempty"`
+}
+
+type exportedSubject struct {
+	name     string
+	config   *exportedConfig
+	versions []srsdk.Schema
+}
+
+type schemaMigrationOut struct {
+	Subject string `human:"Subject" serialized:"subject"`
+	Version int32  `human:"Version" serialized:"version"`
+	Id      int32  `human:"ID" serialized:"id"`
+}
+
+func exportSchemas(cmd *cobra.Command, srClient *srsdk.APIClient, ctx context.Context) error {
+	dir, err := cmd.Flags().GetString("dir")
+	if err != nil {
+		return err
+	}
+
+	if entries, err := os.ReadDir(dir); err == nil && len(entries) > 0 {
+		return errors.Errorf(errors.DirectoryNotEmptyErrorMsg, dir)
+	}
+
+	topLevelConfig, _, err := srClient.DefaultApi.GetTopLevelConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	topLevelMode, _, err := srClient.DefaultApi.GetTopLevelMode(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := writeExportFile(filepath.Join(dir, exportConfigFileName), &exportedConfig{Compatibility: topLevelConfig.CompatibilityLevel, Mode: topLevelMode.Mode}); err != nil {
+		return err
+	}
+
+	subjects, _, err := srClient.DefaultApi.List(ctx, nil)
+	if err != nil {
+		return err
+	}
+	sort.Strings(subjects)
+
+	list := output.NewList(cmd)
+	for _, subject := range subjects {
+		subjectDir := filepath.Join(dir, exportSubjectsDirName, url.PathEscape(subject))
+
+		versions, httpResp, err := srClient.DefaultApi.ListVersions(ctx, subject, nil)
+		if err != nil {
+			return errors.CatchSchemaNotFoundError(err, httpResp)
+		}
+
+		for _, version := range versions {
+			schema, httpResp, err := srClient.DefaultApi.GetSchemaByVersion(ctx, subject, strconv.Itoa(int(version)), nil)
+			if err != nil {
+				return errors.CatchSchemaNotFoundError(err, httpResp)
+			}
+
+			if err := writeExportFile(filepath.Join(subjectDir, exportVersionsDirName, fmt.Sprintf("%d.json", version)), &schema); err != nil {
+				return err
+			}
+			list.Add(&schemaMigrationOut{Subject: subject, Version: schema.Version, Id: schema.Id})
+		}
+
+		config, err := getSubjectLevelSettings(srClient, ctx, subject)
+		if err != nil {
+			return err
+		}
+		if *config != (exportedConfig{}) {
+			if err := writeExportFile(filepath.Join(subjectDir, exportConfigFileName), config); err != nil {
+				return err
+			}
+		}
+	}
+
+	return list.Print()
+}
+
+// getSubjectLevelSettings returns the compatibility and mode of a subject, if they are set for the subject rather than inherited.
+func getSubjectLevelSettings(srClient *srsdk.APIClient, ctx context.Context, subject string) (*exportedConfig, error) {
+	settings := new(exportedConfig)
+
+	config, httpResp, err := srClient.DefaultApi.GetSubjectLevelConfig(ctx, subject, nil)
+	if err != nil && !isNotFound(httpResp) {
+		return nil, err
+	}
+	settings.Compatibility = config.CompatibilityLevel
+
+	mode, httpResp, err := srClient.DefaultApi.GetMode(ctx, subject, nil)
+	if err != nil && !isNotFound(httpResp) {
+		return nil, err
+	}
+	settings.Mode = mode.Mode
+
+	return settings, nil
+}
+
+func isNotFound(httpResp *http.Response) bool {
+	return httpResp != nil && httpResp.StatusCode == http.StatusNotFound
+}
+
+func writeExportFile(path string, v interface{}) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(out, '\n'), 0644)
+}
+
+func importSchemas(cmd *cobra.Command, srClient *srsdk.APIClient, ctx context.Context) error {
+	dir, err := cmd.Flags().GetString("dir")
+	if err != nil {
+		return err
+	}
+
+	preserveIds, err := cmd.Flags().GetBool("preserve-ids")
+	if err != nil {
+		return err
+	}
+
+	topLevelConfig, subjects, err := readExport(dir)
+	if err != nil {
+		return err
+	}
+
+	if preserveIds {
+		mode, _, err := srClient.DefaultApi.GetTopLevelMode(ctx)
+		if err != nil {
+			return err
+		}
+		if mode.Mode != importMode {
+			return errors.NewErrorWithSuggestions(errors.PreserveSchemaIdsErrorMsg, errors.PreserveSchemaIdsSuggestions)
+		}
+	}
+
+	// Compatibility levels are set before the schemas are registered, since each version is checked against the previous ones.
+	if topLevelConfig.Compatibility != "" {
+		if _, _, err := srClient.DefaultApi.UpdateTopLevelConfig(ctx, srsdk.ConfigUpdateRequest{Compatibility: topLevelConfig.Compatibility}); err != nil {
+			return err
+		}
+	}
+	for _, subject := range subjects {
+		if subject.config.Compatibility != "" {
+			if _, _, err := srClient.DefaultApi.UpdateSubjectLevelConfig(ctx, subject.name, srsdk.ConfigUpdateRequest{Compatibility: subject.config.Compatibility}); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The version of each imported schema, by its subject and version in the export, which differ unless IDs are preserved.
+	versions := map[string]int32{}
+
+	list := output.NewList(cmd)
+	for _, schema := range sortSchemasByReferences(subjects) {
+		references := make([]srsdk.SchemaReference, len(schema.References))
+		for i, reference := range schema.References {
+			references[i] = reference
+			if version, ok := versions[schemaKey(reference.Subject, reference.Version)]; ok {
+				references[i].Version = version
+			}
+		}
+
+		req := srsdk.RegisterSchemaRequest{Schema: schema.Schema, SchemaType: schema.SchemaType, References: references}
+		if preserveIds {
+			req.Id = schema.Id
+			req.Version = schema.Version
+		}
+
+		registered, httpResp, err := srClient.DefaultApi.Register(ctx, schema.Subject, req)
+		if err != nil {
+			return errors.CatchSchemaNotFoundError(err, httpResp)
+		}
+
+		version := schema.Version
+		if !preserveIds {
+			subjectVersions, _, err := srClient.DefaultApi.GetVersions(ctx, registered.Id, nil)
+			if err != nil {
+				return err
+			}
+			for _, subjectVersion := range subjectVersions {
+				if subjectVersion.Subject == schema.Subject {
+					version = subjectVersion.Version
+				}
+			}
+		}
+		versions[schemaKey(schema.Subject, schema.Version)] = version
+
+		list.Add(&schemaMigrationOut{Subject: schema.Subject, Version: version, Id: registered.Id})
+	}
+
+	// Modes are set after the schemas are registered, since a subject may be read-only.
+	for _, subject := range subjects {
+		if subject.config.Mode != "" {
+			if _, httpResp, err := srClient.DefaultApi.UpdateMode(ctx, subject.name, srsdk.ModeUpdateRequest{Mode: subject.config.Mode}); err != nil {
+				return errors.CatchSchemaNotFoundError(err, httpResp)
+			}
+		}
+	}
+
+	// The top-level mode is set last, since IDs can only be preserved while Schema Registry is in IMPORT mode.
+	if topLevelConfig.Mode != "" {
+		if _, _, err := srClient.DefaultApi.UpdateTopLevelMode(ctx, srsdk.ModeUpdateRequest{Mode: topLevelConfig.Mode}); err != nil {
+			return err
+		}
+	}
+
+	return list.Print()
+}
+
+// readExport reads the top-level settings and the subjects of an export, with the versions of each subject in ascending order.
+func readExport(dir string) (*exportedConfig, []*exportedSubject, error) {
+	topLevelConfig := new(exportedConfig)
+	if err := readExportFile(filepath.Join(dir, exportConfigFileName), topLevelConfig); err != nil {
+		return nil, nil, err
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dir, exportSubjectsDirName))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, nil, err
+	}
+
+	var subjects []*exportedSubject
+	for _, entry := range entries {
+		name, err := url.PathUnescape(entry.Name())
+		if err != nil {
+			return nil, nil, errors.Errorf(errors.InvalidSchemaExportErrorMsg, dir, err)
+		}
+		subjectDir := filepath.Join(dir, exportSubjectsDirName, entry.Name())
+
+		subject := &exportedSubject{name: name, config: new(exportedConfig)}
+		if err := readExportFile(filepath.Join(subjectDir, exportConfigFileName), subject.config); err != nil && !os.IsNotExist(err) {
+			return nil, nil, err
+		}
+
+		versionEntries, err := os.ReadDir(filepath.Join(subjectDir, exportVersionsDirName))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, nil, err
+		}
+		for _, versionEntry := range versionEntries {
+			var schema srsdk.Schema
+			if err := readExportFile(filepath.Join(subjectDir, exportVersionsDirName, versionEntry.Name()), &schema); err != nil {
+				return nil, nil, err
+			}
+			if schema.Subject != name {
+				return nil, nil, errors.Errorf(errors.InvalidSchemaExportErrorMsg, dir, fmt.Sprintf(`version %d of subject "%s" belongs to subject "%s"`, schema.Version, name, schema.Subject))
+			}
+			subject.versions = append(subject.versions, schema)
+		}
+		sort.Slice(subject.versions, func(i, j int) bool { return subject.versions[i].Version < subject.versions[j].Version })
+
+		subjects = append(subjects, subject)
+	}
+
+	return topLevelConfig, subjects, nil
+}
+
+func readExportFile(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return errors.Errorf(errors.InvalidSchemaExportErrorMsg, path, err)
+	}
+	return nil
+}
+
+// sortSchemasByReferences orders the schemas of an export so that each schema comes after the schemas it references,
+// and after the earlier versions of its subject.
+func sortSchemasByReferences(subjects []*exportedSubject) []srsdk.Schema {
+	schemas := map[string]srsdk.Schema{}
+	previous := map[string]string{}
+	for _, subject := range subjects {
+		for i, schema := range subject.versions {
+			key := schemaKey(schema.Subject, schema.Version)
+			schemas[key] = schema
+			if i > 0 {
+				previous[key] = schemaKey(schema.Subject, subject.versions[i-1].Version)
+			}
+		}
+	}
+
+	var sorted []srsdk.Schema
+	visited := map[string]bool{}
+
+	var visit func(key string)
+	visit = func(key string) {
+		schema, ok := schemas[key]
+		if !ok || visited[key] {
+			return
+		}
+		visited[key] = true
+
+		if previousKey, ok := previous[key]; ok {
+			visit(previousKey)
+		}
+		for _, reference := range schema.References {
+			visit(schemaKey(reference.Subject, reference.Version))
+		}
+		sorted = append(sorted, schema)
+	}
+
+	for _, subject := range subjects {
+		for _, schema := range subject.versions {
+			visit(schemaKey(schema.Subject, schema.Version))
+		}
+	}
+
+	return sorted
+}
+
+func schemaKey(subject string, version int32) string {
+	return strings.Join([]string{subject, strconv.Itoa(int(version))}, "#")
+}
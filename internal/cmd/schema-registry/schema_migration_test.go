@@ -0,0 +1,146 @@
+package schemaregistry
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+	srMock "github.com/confluentinc/schema-registry-sdk-go/mock"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	pcmd "github.com/confluentinc/cli/internal/pkg/cmd"
+)
+
+func newSchemaMigrationCommand(dir string) *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().String("dir", dir, "")
+	cmd.Flags().Bool("preserve-ids", false, "")
+	pcmd.AddOutputFlag(cmd)
+	cmd.SetOut(io.Discard)
+	return cmd
+}
+
+func TestSortSchemasByReferences(t *testing.T) {
+	req := require.New(t)
+
+	subjects := []*exportedSubject{
+		{name: "orders", versions: []srsdk.Schema{
+			{Subject: "orders", Version: 1},
+			{Subject: "orders", Version: 2, References: []srsdk.SchemaReference{{Name: "Customer", Subject: "customers", Version: 2}}},
+		}},
+		{name: "customers", versions: []srsdk.Schema{
+			{Subject: "customers", Version: 1},
+			{Subject: "customers", Version: 2},
+		}},
+	}
+
+	var order []string
+	for _, schema := range sortSchemasByReferences(subjects) {
+		order = append(order, schemaKey(schema.Subject, schema.Version))
+	}
+	req.Equal([]string{"orders#1", "customers#1", "customers#2", "orders#2"}, order)
+}
+
+func TestExportImportSchemas(t *testing.T) {
+	req := require.New(t)
+
+	dir := filepath.Join(t.TempDir(), "backup")
+	notFound := &http.Response{StatusCode: http.StatusNotFound}
+
+	source := &srsdk.APIClient{DefaultApi: &srMock.DefaultApi{
+		GetTopLevelConfigFunc: func(_ context.Context) (srsdk.Config, *http.Response, error) {
+			return srsdk.Config{CompatibilityLevel: "FULL"}, nil, nil
+		},
+		GetTopLevelModeFunc: func(_ context.Context) (srsdk.Mode, *http.Response, error) {
+			return srsdk.Mode{Mode: "READWRITE"}, nil, nil
+		},
+		ListFunc: func(_ context.Context, _ *srsdk.ListOpts) ([]string, *http.Response, error) {
+			return []string{"orders-value", "customer"}, nil, nil
+		},
+		ListVersionsFunc: func(_ context.Context, subject string, _ *srsdk.ListVersionsOpts) ([]int32, *http.Response, error) {
+			if subject == "customer" {
+				return []int32{3}, nil, nil
+			}
+			return []int32{1}, nil, nil
+		},
+		GetSchemaByVersionFunc: func(_ context.Context, subject, version string, _ *srsdk.GetSchemaByVersionOpts) (srsdk.Schema, *http.Response, error) {
+			if subject == "customer" {
+				return srsdk.Schema{Subject: subject, Version: 3, Id: 100001, Schema: `{"type": "string"}`}, nil, nil
+			}
+			return srsdk.Schema{Subject: subject, Version: 1, Id: 100002, Schema: `"Customer"`, References: []srsdk.SchemaReference{{Name: "Customer", Subject: "customer", Version: 3}}}, nil, nil
+		},
+		GetSubjectLevelConfigFunc: func(_ context.Context, subject string, _ *srsdk.GetSubjectLevelConfigOpts) (srsdk.Config, *http.Response, error) {
+			if subject == "customer" {
+				return srsdk.Config{CompatibilityLevel: "NONE"}, nil, nil
+			}
+			return srsdk.Config{}, notFound, srsdk.GenericOpenAPIError{}
+		},
+		GetModeFunc: func(_ context.Context, _ string, _ *srsdk.GetModeOpts) (srsdk.Mode, *http.Response, error) {
+			return srsdk.Mode{}, notFound, srsdk.GenericOpenAPIError{}
+		},
+	}}
+
+	req.NoError(exportSchemas(newSchemaMigrationCommand(dir), source, context.Background()))
+	req.FileExists(filepath.Join(dir, "config.json"))
+	req.FileExists(filepath.Join(dir, "subjects", "customer", "config.json"))
+	req.FileExists(filepath.Join(dir, "subjects", "customer", "versions", "3.json"))
+	req.NoFileExists(filepath.Join(dir, "subjects", "orders-value", "config.json"))
+
+	req.EqualError(exportSchemas(newSchemaMigrationCommand(dir), source, context.Background()), `directory "`+dir+`" is not empty`)
+
+	destinationApi := &srMock.DefaultApi{
+		RegisterFunc: func(_ context.Context, subject string, _ srsdk.RegisterSchemaRequest) (srsdk.RegisterSchemaResponse, *http.Response, error) {
+			if subject == "customer" {
+				return srsdk.RegisterSchemaResponse{Id: 1}, nil, nil
+			}
+			return srsdk.RegisterSchemaResponse{Id: 2}, nil, nil
+		},
+		GetVersionsFunc: func(_ context.Context, id int32, _ *srsdk.GetVersionsOpts) ([]srsdk.SubjectVersion, *http.Response, error) {
+			if id == 1 {
+				return []srsdk.SubjectVersion{{Subject: "customer", Version: 1}}, nil, nil
+			}
+			return []srsdk.SubjectVersion{{Subject: "orders-value", Version: 1}}, nil, nil
+		},
+		UpdateTopLevelConfigFunc: func(_ context.Context, body srsdk.ConfigUpdateRequest) (srsdk.ConfigUpdateRequest, *http.Response, error) {
+			return body, nil, nil
+		},
+		UpdateSubjectLevelConfigFunc: func(_ context.Context, _ string, body srsdk.ConfigUpdateRequest) (srsdk.ConfigUpdateRequest, *http.Response, error) {
+			return body, nil, nil
+		},
+		GetTopLevelModeFunc: func(_ context.Context) (srsdk.Mode, *http.Response, error) {
+			return srsdk.Mode{Mode: "READWRITE"}, nil, nil
+		},
+		UpdateTopLevelModeFunc: func(_ context.Context, body srsdk.ModeUpdateRequest) (srsdk.ModeUpdateRequest, *http.Response, error) {
+			return body, nil, nil
+		},
+	}
+	destination := &srsdk.APIClient{DefaultApi: destinationApi}
+
+	req.NoError(importSchemas(newSchemaMigrationCommand(dir), destination, context.Background()))
+
+	registerCalls := destinationApi.RegisterCalls()
+	req.Len(registerCalls, 2)
+	req.Equal("customer", registerCalls[0].Subject)
+	req.Equal("orders-value", registerCalls[1].Subject)
+	req.Equal([]srsdk.SchemaReference{{Name: "Customer", Subject: "customer", Version: 1}}, registerCalls[1].Body.References)
+	req.Zero(registerCalls[1].Body.Id)
+
+	req.Equal("FULL", destinationApi.UpdateTopLevelConfigCalls()[0].Body.Compatibility)
+	req.Equal("NONE", destinationApi.UpdateSubjectLevelConfigCalls()[0].Body.Compatibility)
+	req.Len(destinationApi.UpdateTopLevelModeCalls(), 1)
+	req.Equal("READWRITE", destinationApi.UpdateTopLevelModeCalls()[0].Body.Mode)
+
+	cmd := newSchemaMigrationCommand(dir)
+	req.NoError(cmd.Flags().Set("preserve-ids", "true"))
+	err := importSchemas(cmd, destination, context.Background())
+	req.EqualError(err, "schema IDs can only be preserved when Schema Registry is in IMPORT mode")
+
+	req.NoError(os.WriteFile(filepath.Join(dir, "subjects", "customer", "versions", "3.json"), []byte(`{"subject": "orders-value"}`), 0644))
+	_, _, err = readExport(dir)
+	req.Error(err)
+}
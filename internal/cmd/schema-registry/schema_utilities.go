@@ -55,11 +55,15 @@ func RegisterSchemaWithAuth(cmd *cobra.Command, schemaCfg *RegisterSchemaConfigs
 }
 
 func ReadSchemaRefs(cmd *cobra.Command) ([]srsdk.SchemaReference, error) {
-	var refs []srsdk.SchemaReference
 	references, err := cmd.Flags().GetString("references")
 	if err != nil {
 		return nil, err
 	}
+	return ReadSchemaReferences(references)
+}
+
+func ReadSchemaReferences(references string) ([]srsdk.SchemaReference, error) {
+	var refs []srsdk.SchemaReference
 	if references != "" {
 		refBlob, err := os.ReadFile(references)
 		if err != nil {
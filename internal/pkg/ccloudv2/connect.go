@@ -2,7 +2,10 @@ package ccloudv2
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
 
@@ -89,6 +92,48 @@ func (c *Client) ResumeConnector(connectorName, environmentId, kafkaClusterId st
 	return errors.CatchCCloudV2Error(err, httpResp)
 }
 
+// RestartConnector restarts a connector and, if includeTasks is set, its tasks.
+// If onlyFailed is set, only the connector and tasks which have failed are restarted.
+// The Connect SDK does not support this endpoint yet, so the request is sent directly.
+func (c *Client) RestartConnector(connectorName, environmentId, kafkaClusterId string, includeTasks, onlyFailed bool) error {
+	query := url.Values{}
+	query.Set("includeTasks", strconv.FormatBool(includeTasks))
+	query.Set("onlyFailed", strconv.FormatBool(onlyFailed))
+
+	path := fmt.Sprintf("/connect/v1/environments/%s/clusters/%s/connectors/%s/restart?%s", url.PathEscape(environmentId), url.PathEscape(kafkaClusterId), url.PathEscape(connectorName), query.Encode())
+	return c.doConnectRequest(http.MethodPost, path)
+}
+
+// RestartConnectorTask restarts a single task of a connector.
+// The Connect SDK does not support this endpoint yet, so the request is sent directly.
+func (c *Client) RestartConnectorTask(connectorName, environmentId, kafkaClusterId string, taskId int32) error {
+	path := fmt.Sprintf("/connect/v1/environments/%s/clusters/%s/connectors/%s/tasks/%d/restart", url.PathEscape(environmentId), url.PathEscape(kafkaClusterId), url.PathEscape(connectorName), taskId)
+	return c.doConnectRequest(http.MethodPost, path)
+}
+
+func (c *Client) doConnectRequest(method, path string) error {
+	cfg := c.ConnectClient.GetConfig()
+
+	req, err := http.NewRequest(method, cfg.Servers[0].URL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.AuthToken)
+	req.Header.Set("User-Agent", cfg.UserAgent)
+
+	httpResp, err := cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode >= http.StatusMultipleChoices {
+		return errors.CatchCCloudV2Error(errors.New(httpResp.Status), httpResp)
+	}
+	return nil
+}
+
 func (c *Client) ListConnectorPlugins(environmentId, kafkaClusterId string) ([]connectv1.InlineResponse2002, *http.Response, error) {
 	req := c.ConnectClient.PluginsV1Api.ListConnectv1ConnectorPlugins(c.connectApiContext(), environmentId, kafkaClusterId)
 	return c.ConnectClient.PluginsV1Api.ListConnectv1ConnectorPluginsExecute(req)
@@ -1,9 +1,13 @@
 package ccloudv2
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 
 	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
 
@@ -194,3 +198,43 @@ func (c *KafkaRestClient) ListKafkaTopics(clusterId string) (kafkarestv3.TopicDa
 	req := c.TopicV3Api.ListKafkaTopics(c.context(), clusterId)
 	return c.TopicV3Api.ListKafkaTopicsExecute(req)
 }
+
+// UpdateKafkaTopicPartitionCount increases the number of partitions of a topic.
+// The Kafka REST SDK does not support this endpoint yet, so the request is sent directly.
+func (c *KafkaRestClient) UpdateKafkaTopicPartitionCount(clusterId, topicName string, partitionsCount int32) (*http.Response, error) {
+	body, err := json.Marshal(map[string]int32{"partitions_count": partitionsCount})
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/kafka/v3/clusters/%s/topics/%s", c.GetUrl(), url.PathEscape(clusterId), url.PathEscape(topicName))
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.AuthToken)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.GetConfig().UserAgent)
+
+	httpResp, err := c.GetConfig().HTTPClient.Do(req)
+	if err != nil {
+		return httpResp, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode >= http.StatusMultipleChoices {
+		respBody, err := io.ReadAll(httpResp.Body)
+		if err != nil {
+			return httpResp, err
+		}
+
+		restErr := new(kafkarest.V3Error)
+		if err := json.Unmarshal(respBody, restErr); err != nil || restErr.Message == "" {
+			return httpResp, errors.Errorf(errors.KafkaRestErrorMsg, req.Method, req.URL, httpResp.Status)
+		}
+		return httpResp, restErr
+	}
+
+	return httpResp, nil
+}
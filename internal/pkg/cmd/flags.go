@@ -335,6 +335,17 @@ func AddSchemaTypeFlag(cmd *cobra.Command) {
 	})
 }
 
+func AddKeyFormatFlag(cmd *cobra.Command) {
+	arr := []string{"string", "avro", "jsonschema", "protobuf"}
+	str := utils.ArrayToCommaDelimitedString(arr)
+
+	cmd.Flags().String("key-format", "string", fmt.Sprintf("Format of message key as %s. Note that schema references are not supported for avro.", str))
+
+	RegisterFlagCompletionFunc(cmd, "key-format", func(_ *cobra.Command, _ []string) []string {
+		return arr
+	})
+}
+
 func AddValueFormatFlag(cmd *cobra.Command) {
 	arr := []string{"string", "avro", "jsonschema", "protobuf"}
 	str := utils.ArrayToCommaDelimitedString(arr)
@@ -34,3 +34,12 @@ func OnPremSchemaRegistrySet() *pflag.FlagSet {
 	set.SortFlags = false
 	return set
 }
+
+func OnPremConnectSet() *pflag.FlagSet {
+	set := pflag.NewFlagSet("onprem-connect", pflag.ExitOnError)
+	set.String("connect-url", "", "The URL of the Connect REST API. If not specified, the URL is read from the MDS cluster registry.")
+	set.String("cluster-name", "", "The name of the Connect cluster in the MDS cluster registry, if more than one Connect cluster is registered.")
+	set.String("ca-cert-path", "", "Path to a PEM-encoded CA to verify the Connect REST API.")
+	set.SortFlags = false
+	return set
+}
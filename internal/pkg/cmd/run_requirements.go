@@ -17,6 +17,8 @@ const (
 	RequireNonCloudLogin                    = "non-cloud-login"
 	RequireOnPremLogin                      = "on-prem-login"
 	RequireUpdatesEnabled                   = "updates-enabled"
+	// NoRunRequirement allows a command to run regardless of the requirements of its parents, such as a command which works offline.
+	NoRunRequirement = "none"
 )
 
 // ErrIfMissingRunRequirement returns an error when a command or its parent doesn't meet a requirement;
@@ -27,6 +29,10 @@ func ErrIfMissingRunRequirement(cmd *cobra.Command, cfg *v1.Config) error {
 	}
 
 	if requirement, ok := cmd.Annotations[RunRequirement]; ok {
+		if requirement == NoRunRequirement {
+			return nil
+		}
+
 		var f func() error
 
 		switch requirement {
@@ -139,3 +139,12 @@ func TestErrIfMissingRunRequirement_Subcommand(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, err, v1.RequireCloudLoginErr)
 }
+
+func TestErrIfMissingRunRequirement_NoRunRequirement(t *testing.T) {
+	a := &cobra.Command{Annotations: map[string]string{RunRequirement: RequireCloudLogin}}
+	b := &cobra.Command{Annotations: map[string]string{RunRequirement: NoRunRequirement}}
+	a.AddCommand(b)
+
+	err := ErrIfMissingRunRequirement(b, noContextCfg)
+	require.NoError(t, err)
+}
@@ -372,3 +372,19 @@ func CatchNoSubjectLevelConfigError(err error, r *http.Response, subject string)
 
 	return err
 }
+
+func CatchNoSubjectLevelModeError(err error, r *http.Response, subject string) error {
+	if err == nil {
+		return nil
+	}
+
+	if r == nil {
+		return err
+	}
+
+	if strings.Contains(r.Status, "Not Found") {
+		return errors.New(fmt.Sprintf(NoSubjectLevelModeErrorMsg, subject))
+	}
+
+	return err
+}
@@ -58,6 +58,24 @@ const (
 	InvalidCloudSuggestions            = "To list available connector plugin types, use `confluent connect plugin list`."
 	ConnectLogEventsNotEnabledErrorMsg = "Connect Log Events are not enabled for this organization"
 
+	InvalidConnectorConfigErrorMsg     = "connector configuration has %d invalid config(s)"
+	InvalidConnectorConfigSuggestions  = "Use `--skip-validation` to submit the configuration without validating it first."
+	InvalidConnectorDirErrorMsg        = `invalid connector directory "%s": %v`
+	ResolveConfigTemplateErrorMsg      = `unable to resolve the placeholders of config "%s" in "%s": %v`
+	UnsetEnvVariableErrorMsg           = `environment variable "%s" is not set`
+	UnsetEnvVariableSuggestions        = "Set the environment variable, or escape the placeholder as `$${%s}` to keep it as is."
+	MissingPropertiesFileKeyErrorMsg   = `key "%s" not found in properties file "%s"`
+	UnsetContextPropertyErrorMsg       = `context property "%s" is not set`
+	UnsetContextPropertySuggestions    = "Set the environment with `confluent environment use`, the Kafka cluster with `confluent kafka cluster use`, and its API key with `confluent api-key use`."
+	UnknownConnectorTaskErrorMsg       = `unknown task %d of connector "%s"`
+	InvalidWatchIntervalErrorMsg       = "`--interval` must be positive"
+	ConnectorFailedErrorMsg            = `connector "%s" has failed`
+	ConnectorFailedSuggestions         = "Restart the failed connector and tasks with `confluent connect cluster restart %s --failed-only`."
+	NoConnectClusterErrorMsg           = "no Connect cluster is registered with the MDS cluster registry"
+	NoConnectClusterSuggestions        = "Specify the URL of the Connect REST API with `--connect-url`, or register the Connect cluster with `confluent cluster register`."
+	MultipleConnectClustersErrorMsg    = "more than one Connect cluster is registered with the MDS cluster registry"
+	MultipleConnectClustersSuggestions = "Specify the Connect cluster with `--cluster-name` or `--connect-url`."
+
 	// environment command
 	EnvNotFoundErrorMsg    = `environment "%s" not found`
 	EnvNotFoundSuggestions = "List available environments with `confluent environment list`."
@@ -161,17 +179,52 @@ const (
 	MdsUrlNotFoundSuggestions         = "Pass the `--url` flag or set the `CONFLUENT_PLATFORM_MDS_URL` environment variable."
 	KafkaClusterMissingPrefixErrorMsg = `Kafka cluster "%s" is missing required prefix "lkc-"`
 
+	// kafka consumer-group commands
+	ConsumerGroupHasActiveMembersErrorMsg    = `consumer group "%s" has %d active members`
+	ConsumerGroupHasActiveMembersSuggestions = "Stop every consumer in the consumer group before resetting its offsets, or preview the new offsets with `--dry-run`."
+	FailedToResetOffsetsErrorMsg             = `failed to reset offsets for consumer group "%s"`
+	InvalidTopicPartitionsErrorMsg           = `invalid topic "%s": must be formatted as "<topic>" or "<topic>:<partitions>", where partitions is a comma-separated list of partition IDs`
+	InvalidOffsetsFileErrorMsg               = "failed to read offsets file"
+	InvalidOffsetsFileRecordErrorMsg         = `invalid line "%s" in offsets file: must be formatted as "<topic>,<partition>,<offset>"`
+
 	// kafka topic commands
 	FailedToCreateProducerErrorMsg       = "failed to create producer: %v"
 	FailedToCreateConsumerErrorMsg       = "failed to create consumer: %v"
 	FailedToCreateAdminClientErrorMsg    = "failed to create confluent-kafka-go admin client: %v"
 	InvalidOffsetErrorMsg                = "offset value must be a non-negative integer"
+	InvalidMaxMessagesErrorMsg           = "`--max-messages` must be a non-negative integer"
+	InvalidCountErrorMsg                 = "`--count` must be a non-negative integer"
+	InvalidRateErrorMsg                  = "`--rate` must be a non-negative number"
+	FailOnTimeoutWithoutTimeoutErrorMsg  = "`--fail-on-timeout` requires a non-zero `--timeout`"
+	ConsumeTimeoutErrorMsg               = "no messages were consumed in the last %s"
+	InvalidTimestampErrorMsg             = `invalid timestamp "%s": must be in RFC 3339 format (for example, "2006-01-02T15:04:05Z") or milliseconds since the epoch`
+	ResolveTimestampOffsetsErrorMsg      = "failed to resolve the offsets of timestamp %d"
+	InvalidSinceErrorMsg                 = "`--since` must be a positive duration"
+	InvalidFilterErrorMsg                = `invalid filter "%s": must be formatted as "<field><operator><value>", where the operator is one of "==", "!=", "=~", or "!~"`
+	InvalidMessageFieldErrorMsg          = `invalid message field "%s": must be one of "topic", "partition", "offset", "timestamp", "header.<name>", "key", "key.<path>", "value", or "value.<path>"`
+	FailedToExportSchemaErrorMsg         = "failed to export schema with ID %d"
+	ExportSchemaReferencesErrorMsg       = "cannot export schema with ID %d: schemas with references are not supported"
+	ExportSchemaReferencesSuggestions    = "Omit `--include-schemas` and the destination Schema Registry flags to keep the schema IDs of the messages as is."
+	FailedToRegisterSchemaErrorMsg       = `failed to register schema with ID %d under subject "%s"`
+	InvalidArchiveEntryErrorMsg          = "invalid entry %d in topic archive: must be a JSON object with a \"schema\" or \"message\" field"
+	MissingArchivedSchemaErrorMsg        = "schema with ID %d is missing from the topic archive; export the topic with `--include-schemas` to remap schema IDs"
+	InvalidTopicManifestErrorMsg         = `invalid topic manifest "%s": %v`
+	ChangeTopicPartitionsErrorMsg        = `cannot change the number of partitions of topic "%s" from %d to %d`
+	ChangeTopicPartitionsSuggestions     = "Set the number of partitions in the file to the current number of partitions, or remove it to leave it unchanged."
+	ChangeTopicReplicationFactorErrorMsg = `cannot change the replication factor of topic "%s" from %d to %d`
+	DecreasePartitionCountErrorMsg       = `the number of partitions of topic "%s" can only be increased from %d`
 	InvalidSecurityProtocolErrorMsg      = "security protocol not supported: %v"
 	TopicExistsOnPremErrorMsg            = `topic "%s" already exists for the Kafka cluster`
 	TopicExistsOnPremSuggestions         = "To list topics for the cluster, use `confluent kafka topic list --url <url>`."
 	FailedToProduceErrorMsg              = "failed to produce offset %d: %s\n"
 	FailedToFindSchemaIDErrorMsg         = "failed to find schema ID in topic data"
 	MissingKeyErrorMsg                   = "missing key in message"
+	MissingHeadersErrorMsg               = "missing headers in message"
+	InvalidHeaderErrorMsg                = `invalid header "%s": headers must be formatted as "key:value"`
+	InvalidJsonMessageErrorMsg           = "failed to parse message as JSON: %v"
+	InvalidMessageEncodingErrorMsg       = `failed to decode %s message data: %v`
+	UnknownMessageEncodingErrorMsg       = `unknown encoding "%s" of message data: only "%s" is supported`
+	InvalidMaxInFlightErrorMsg           = "`--max-in-flight` must be a positive integer"
 	UnknownValueFormatErrorMsg           = "unknown value schema format"
 	TopicExistsErrorMsg                  = `topic "%s" already exists for Kafka cluster "%s"`
 	TopicExistsSuggestions               = ListTopicSuggestions
@@ -194,6 +247,8 @@ const (
 	AvroReferenceNotSupportedErrorMsg = "avro reference not supported in cloud CLI"
 	ProtoSchemaInvalidErrorMsg        = "the protobuf schema is invalid"
 	ProtoDocumentInvalidErrorMsg      = "the protobuf document is invalid"
+	LocalSchemaRequiredErrorMsg       = "must pass `--%s` to use format \"%s\" with `--no-schema-registry`"
+	NoSchemaRegistryRequiredErrorMsg  = "`--%s` can only be used with `--no-schema-registry`"
 
 	// ksql commands
 	KsqlDBNoServiceAccountErrorMsg = `ACLs do not need to be configured for the ksqlDB cluster, "%s", because it was created with user-level access to the Kafka cluster`
@@ -225,9 +280,27 @@ const (
 	SchemaNotFoundSuggestions                = "List available subjects with `confluent schema-registry subject list`.\n" +
 		"List available versions with `confluent schema-registry subject describe`."
 	NoSubjectLevelConfigErrorMsg = `subject "%s" does not have subject-level compatibility configured`
+	NoSubjectLevelModeErrorMsg   = `subject "%s" does not have subject-level mode configured`
 	SRInvalidPackageTypeErrorMsg = `"%s" is an invalid package type`
 	SRInvalidPackageSuggestions  = "Allowed values for `--package` flag are: %s."
 	SRInvalidPackageUpgrade      = "Environment \"%s\" is already using the Stream Governance \"%s\" package.\n"
+	SchemaDiffVersionsErrorMsg   = "must pass either `--version` twice, or `--version` at most once with `--schema`"
+	SchemaTypeMismatchErrorMsg   = "cannot compare %s schema to %s schema"
+	UnknownSchemaTypeErrorMsg    = `unknown schema type "%s"`
+	ParseSchemaErrorMsg          = "failed to parse %s schema: %v"
+	DirectoryNotEmptyErrorMsg    = `directory "%s" is not empty`
+	InvalidSchemaExportErrorMsg  = `invalid schema export "%s": %v`
+	PreserveSchemaIdsErrorMsg    = "schema IDs can only be preserved when Schema Registry is in IMPORT mode"
+	PreserveSchemaIdsSuggestions = "Set the mode of the destination Schema Registry to IMPORT before importing, and back to READWRITE afterward."
+
+	InvalidSchemaLintRulesErrorMsg = `invalid lint rules file "%s": %v`
+	SchemaLintIssuesErrorMsg       = `found %d issue(s) in schema "%s"`
+
+	InvalidCompatibilityErrorMsg    = `invalid compatibility "%s"`
+	InvalidCompatibilitySuggestions = "Allowed values for `--compatibility` flag are: %s."
+	InvalidModeErrorMsg             = `invalid mode "%s"`
+	InvalidModeSuggestions          = "Allowed values for the mode are: %s."
+	ConfigUpdateFlagsErrorMsg       = "must pass at least one of `--compatibility`, `--normalize`, or `--compatibility-group`"
 
 	// secret commands
 	EnterInputTypeErrorMsg    = "enter %s"
@@ -384,6 +457,8 @@ const (
 
 	// Flag Errors
 	ProhibitedFlagCombinationErrorMsg = "cannot use `--%s` and `--%s` flags at the same time"
+	InvalidFlagValueErrorMsg          = "invalid value \"%s\" for `--%s` flag"
+	InvalidFlagValueSuggestions       = "The possible values for flag `--%s` are: %s."
 
 	// catcher
 	CCloudBackendErrorPrefix           = "Confluent Cloud backend error"
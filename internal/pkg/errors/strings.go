@@ -30,8 +30,13 @@ const (
 	UnregisteredClusterMsg = "Successfully unregistered the cluster %s from the Cluster Registry.\n"
 
 	// connector commands
-	PausedConnectorMsg  = "Paused connector \"%s\".\n"
-	ResumedConnectorMsg = "Resumed connector \"%s\".\n"
+	PausedConnectorMsg        = "Paused connector \"%s\".\n"
+	ResumedConnectorMsg       = "Resumed connector \"%s\".\n"
+	ValidConnectorConfigMsg   = "Connector configuration is valid.\n"
+	ConnectorsUpToDateMsg     = "All connectors are up to date.\n"
+	PruneConnectorsConfirmMsg = "Are you sure you want to delete %d connectors which are not in the directory?"
+	RestartedConnectorMsg     = "Restarted connector \"%s\".\n"
+	RestartedConnectorTaskMsg = "Restarted task %d of connector \"%s\".\n"
 
 	// environment commands
 	UsingEnvMsg = "Now using \"%s\" as the default (active) environment.\n"
@@ -48,10 +53,16 @@ const (
 
 	// kafka topic commands
 	StartingProducerMsg      = "Starting Kafka Producer. Use Ctrl-C or Ctrl-D to exit."
+	ProducerSummaryMsg       = "Produced %d messages (%d failed) in %s (%.1f messages/s).\n"
+	ProducerPartitionMsg     = "Partition %d: %d messages\n"
 	StoppingConsumerMsg      = "Stopping Consumer."
 	StartingConsumerMsg      = "Starting Kafka Consumer. Use Ctrl-C to exit."
+	ExportedTopicMsg         = "Exported %d messages from topic \"%s\" to \"%s\".\n"
 	UpdateTopicConfigMsg     = "Updated the following configuration values for topic \"%s\":\n"
 	UpdateTopicConfigRestMsg = "Updated the following configuration values for topic \"%s\" (read-only configs were not updated):\n"
+	TopicsUpToDateMsg        = "All topics are up to date.\n"
+	PruneTopicsConfirmMsg    = "Are you sure you want to delete %d topics which are not in the file?"
+	NoTopicDifferencesMsg    = "The topics of Kafka clusters \"%s\" and \"%s\" have no differences.\n"
 
 	// kafka mirror commands
 	RestProxyNotAvailableMsg = "Kafka REST is not enabled: the operation is only supported with Kafka REST proxy."
@@ -88,6 +99,11 @@ const (
 	ExporterActionMsg                   = "%s schema exporter \"%s\".\n"
 	SchemaRegistryClusterDeletedMsg     = "Deleted Schema Registry cluster for environment \"%s\".\n"
 	SchemaRegistryClusterUpgradedMsg    = "The Stream Governance package for environment \"%s\" has been upgraded to \"%s\".\n"
+	SchemaCompatibilityLevelMsg         = "Compatibility level of subject \"%s\": %s\n"
+	NoSchemaDifferencesMsg              = "No differences found between the schemas.\n"
+	NoSchemaLintIssuesMsg               = "No issues found in schema \"%s\".\n"
+	UpdatedTopLevelConfigMsg            = "Successfully updated the top-level configuration.\n"
+	UpdatedSubjectLevelConfigMsg        = "Successfully updated the configuration of subject \"%s\".\n"
 
 	// secret commands
 	UpdateSecretFileMsg = "Updated the encrypted secrets."
@@ -22,6 +22,9 @@ const (
 	SRCredsNotSetReason       = "no Schema Registry API key or secret specified"
 	SRCredsNotSetSuggestions  = "Pass the `--schema-registry-api-key` and `--schema-registry-api-secret` flags to specify the Schema Registry API key and secret."
 
+	// kafka topic update command
+	IncreasePartitionCountWarning = "Warning: If the messages of this topic have keys, increasing the number of partitions changes the partition of messages with the same key, so messages produced before and after the change may be consumed out of order."
+
 	//kafka rest
 	AssumingHttpProtocol  = "Assuming http protocol.\n"
 	AssumingHttpsProtocol = "Assuming https protocol.\n"
@@ -23,6 +23,10 @@ type V3Error struct {
 	Message string `json:"message"`
 }
 
+func (e *V3Error) Error() string {
+	return fmt.Sprintf("REST request failed: %v (%v)", e.Message, e.Code)
+}
+
 func NewError(url string, err error, httpResp *http.Response) error {
 	switch e := err.(type) {
 	case *neturl.Error:
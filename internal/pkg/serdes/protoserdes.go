@@ -34,18 +34,22 @@ func (protoProvider *ProtoSerializationProvider) encode(str string) ([]byte, err
 	// In our case, index array is always [0].
 	indexBytes := []byte{0x0}
 
+	data, err := protoProvider.encodeMessage(str)
+	if err != nil {
+		return nil, err
+	}
+	data = append(indexBytes, data...)
+	return data, nil
+}
+
+func (protoProvider *ProtoSerializationProvider) encodeMessage(str string) ([]byte, error) {
 	// Convert from Json string to proto message type.
 	if err := jsonpb.UnmarshalString(str, protoProvider.message); err != nil {
 		return nil, errors.New(errors.ProtoDocumentInvalidErrorMsg)
 	}
 
 	// Serialize proto message type to binary format.
-	data, err := proto.Marshal(protoProvider.message)
-	if err != nil {
-		return nil, err
-	}
-	data = append(indexBytes, data...)
-	return data, nil
+	return proto.Marshal(protoProvider.message)
 }
 
 type ProtoDeserializationProvider struct {
@@ -68,8 +72,10 @@ func (protoProvider *ProtoDeserializationProvider) GetSchemaName() string {
 func (protoProvider *ProtoDeserializationProvider) decode(data []byte) (string, error) {
 	// Index array indicates which message in the file we're referring to.
 	// In our case, we simply ignore the index array [0].
-	data = data[1:]
+	return protoProvider.decodeMessage(data[1:])
+}
 
+func (protoProvider *ProtoDeserializationProvider) decodeMessage(data []byte) (string, error) {
 	// Convert from binary format to proto message type.
 	err := proto.Unmarshal(data, protoProvider.message)
 	if err != nil {
@@ -73,6 +73,14 @@ func Serialize(provider SerializationProvider, str string) ([]byte, error) {
 	return provider.encode(str)
 }
 
+// SerializePlain encodes data for topics which do not use Schema Registry, without the Protobuf message indexes of its wire format.
+func SerializePlain(provider SerializationProvider, str string) ([]byte, error) {
+	if protoProvider, ok := provider.(*ProtoSerializationProvider); ok {
+		return protoProvider.encodeMessage(str)
+	}
+	return provider.encode(str)
+}
+
 type DeserializationProvider interface {
 	LoadSchema(string, map[string]string) error
 	decode([]byte) (string, error)
@@ -82,3 +90,11 @@ type DeserializationProvider interface {
 func Deserialize(provider DeserializationProvider, data []byte) (string, error) {
 	return provider.decode(data)
 }
+
+// DeserializePlain decodes data from topics which do not use Schema Registry, without the Protobuf message indexes of its wire format.
+func DeserializePlain(provider DeserializationProvider, data []byte) (string, error) {
+	if protoProvider, ok := provider.(*ProtoDeserializationProvider); ok {
+		return protoProvider.decodeMessage(data)
+	}
+	return provider.decode(data)
+}
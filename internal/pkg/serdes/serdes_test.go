@@ -277,6 +277,28 @@ func TestProtobufSerdesValid(t *testing.T) {
 	req.NoError(os.RemoveAll(dir))
 }
 
+func TestProtobufSerdesPlain(t *testing.T) {
+	req := require.New(t)
+
+	schemaPath := filepath.Join(t.TempDir(), "person.proto")
+	req.NoError(os.WriteFile(schemaPath, []byte(`syntax = "proto3"; message Person { string name = 1; }`), 0644))
+
+	expectedString := `{"name":"abc"}`
+	expectedBytes := []byte{10, 3, 97, 98, 99}
+
+	serializationProvider, _ := GetSerializationProvider(PROTOBUFSCHEMANAME)
+	req.NoError(serializationProvider.LoadSchema(schemaPath, map[string]string{}))
+	data, err := SerializePlain(serializationProvider, expectedString)
+	req.NoError(err)
+	req.Equal(expectedBytes, data)
+
+	deserializationProvider, _ := GetDeserializationProvider(PROTOBUFSCHEMANAME)
+	req.NoError(deserializationProvider.LoadSchema(schemaPath, map[string]string{}))
+	str, err := DeserializePlain(deserializationProvider, expectedBytes)
+	req.NoError(err)
+	req.Equal(expectedString, str)
+}
+
 func TestProtobufSerdesReference(t *testing.T) {
 	req := require.New(t)
 
@@ -10,9 +10,18 @@ func (s *CLITestSuite) TestConnect() {
 	// TODO: add --config flag to all commands or ENVVAR instead of using standard config file location
 	tests := []CLITest{
 		{args: "connect --help", fixture: "connect/help.golden"},
+		{args: "connect cluster apply --help", fixture: "connect/cluster/apply-help.golden"},
+		{args: "connect cluster apply --cluster lkc-123 --dir test/fixtures/input/connect/apply --dry-run", env: []string{"KAFKA_API_SECRET=secret"}, fixture: "connect/cluster/apply-dry-run.golden"},
+		{args: "connect cluster apply --cluster lkc-123 --dir test/fixtures/input/connect/apply --dry-run -o json", env: []string{"KAFKA_API_SECRET=secret"}, fixture: "connect/cluster/apply-dry-run-json.golden"},
+		{args: "connect cluster apply --cluster lkc-123 --dir test/fixtures/input/connect/apply", env: []string{"KAFKA_API_SECRET=secret"}, fixture: "connect/cluster/apply.golden"},
+		{args: "connect cluster apply --cluster lkc-123 --dir test/fixtures/input/connect/apply", fixture: "connect/cluster/apply-missing-secret.golden", wantErrCode: 1},
+		{args: "connect cluster apply --cluster lkc-123 --dir test/fixtures/input/connect/apply-prune --prune --force", fixture: "connect/cluster/apply-prune.golden"},
 		{args: "connect cluster create --cluster lkc-123 --config-file test/fixtures/input/connect/config.yaml -o json", fixture: "connect/cluster/create-json.golden"},
 		{args: "connect cluster create --cluster lkc-123 --config-file test/fixtures/input/connect/config.yaml -o yaml", fixture: "connect/cluster/create-yaml.golden"},
 		{args: "connect cluster create --cluster lkc-123 --config-file test/fixtures/input/connect/config.yaml", fixture: "connect/cluster/create.golden"},
+		{args: "connect cluster create --cluster lkc-123 --config-file test/fixtures/input/connect/config-invalid.json", fixture: "connect/cluster/create-invalid.golden", wantErrCode: 1},
+		{args: "connect cluster create --cluster lkc-123 --config-file test/fixtures/input/connect/config-template.json", env: []string{"AZBLOB_ACCOUNT_NAME=azsink"}, fixture: "connect/cluster/create.golden"},
+		{args: "connect cluster create --cluster lkc-123 --config-file test/fixtures/input/connect/config-template.json", fixture: "connect/cluster/create-template-missing-variable.golden", wantErrCode: 1},
 		{args: "connect cluster delete lcc-123 --cluster lkc-123 --force", fixture: "connect/cluster/delete.golden"},
 		{args: "connect cluster delete lcc-123 --cluster lkc-123", preCmdFuncs: []bincover.PreCmdFunc{stdinPipeFunc(strings.NewReader("az-connector\n"))}, fixture: "connect/cluster/delete-prompt.golden"},
 		{args: "connect cluster describe lcc-123 --cluster lkc-123 -o json", fixture: "connect/cluster/describe-json.golden"},
@@ -22,6 +31,20 @@ func (s *CLITestSuite) TestConnect() {
 		{args: "connect cluster list --cluster lkc-123 -o yaml", fixture: "connect/cluster/list-yaml.golden"},
 		{args: "connect cluster list --cluster lkc-123", fixture: "connect/cluster/list.golden"},
 		{args: "connect cluster update lcc-123 --cluster lkc-123 --config-file test/fixtures/input/connect/config.yaml", fixture: "connect/cluster/update.golden"},
+		{args: "connect cluster update lcc-123 --cluster lkc-123 --config-file test/fixtures/input/connect/config-invalid.json", fixture: "connect/cluster/update-invalid.golden", wantErrCode: 1},
+		{args: "connect cluster update lcc-123 --cluster lkc-123 --config-file test/fixtures/input/connect/config-invalid.json --skip-validation", fixture: "connect/cluster/update.golden"},
+		{args: "connect cluster validate --help", fixture: "connect/cluster/validate-help.golden"},
+		{args: "connect cluster validate --cluster lkc-123 --config-file test/fixtures/input/connect/config.yaml -o json", fixture: "connect/cluster/validate-json.golden"},
+		{args: "connect cluster validate --cluster lkc-123 --config-file test/fixtures/input/connect/config.yaml", fixture: "connect/cluster/validate.golden"},
+		{args: "connect cluster validate --cluster lkc-123 --config-file test/fixtures/input/connect/config-invalid.json -o json", fixture: "connect/cluster/validate-invalid-json.golden", wantErrCode: 1},
+		{args: "connect cluster validate --cluster lkc-123 --config-file test/fixtures/input/connect/config-invalid.json", fixture: "connect/cluster/validate-invalid.golden", wantErrCode: 1},
+		{args: "connect cluster describe lcc-123 --cluster lkc-123 --watch -o json", fixture: "connect/cluster/describe-watch-json.golden", wantErrCode: 1},
+		{args: "connect cluster restart --help", fixture: "connect/cluster/restart-help.golden"},
+		{args: "connect cluster restart lcc-123 --cluster lkc-123", fixture: "connect/cluster/restart.golden"},
+		{args: "connect cluster restart lcc-123 --cluster lkc-123 --failed-only", fixture: "connect/cluster/restart.golden"},
+		{args: "connect cluster restart lcc-123 --cluster lkc-123 --task 1", fixture: "connect/cluster/restart-task.golden"},
+		{args: "connect cluster restart lcc-123 --cluster lkc-123 --task 5", fixture: "connect/cluster/restart-task-unknown.golden", wantErrCode: 1},
+		{args: "connect cluster restart lcc-123 --cluster lkc-123 --task 1 --failed-only", fixture: "connect/cluster/restart-task-failed-only.golden", wantErrCode: 1},
 		{args: "connect event describe", fixture: "connect/event-describe.golden"},
 
 		//Tests based on new config
@@ -79,3 +102,32 @@ func (s *CLITestSuite) TestConnectPlugin() {
 		s.runIntegrationTest(tt)
 	}
 }
+
+func (s *CLITestSuite) TestConnectOnPrem() {
+	connectUrl := s.TestBackend.GetConnectUrl()
+	tests := []CLITest{
+		{args: "connect cluster --help", fixture: "connect/cluster/onprem/help.golden"},
+		{args: "connect cluster create --help", fixture: "connect/cluster/onprem/create-help.golden"},
+		{args: "connect cluster create --config-file test/fixtures/input/connect/config.yaml --connect-url " + connectUrl, fixture: "connect/cluster/onprem/create.golden"},
+		{args: "connect cluster create --config-file test/fixtures/input/connect/config.yaml --connect-url " + connectUrl + " -o json", fixture: "connect/cluster/onprem/create-json.golden"},
+		{args: "connect cluster create --config-file test/fixtures/input/connect/config-config-provider.json --connect-url " + connectUrl, fixture: "connect/cluster/onprem/create-config-provider.golden"},
+		{args: "connect cluster create --config-file test/fixtures/input/connect/config-config-provider.json --resolve-templates --connect-url " + connectUrl, fixture: "connect/cluster/onprem/create-resolve-templates.golden", wantErrCode: 1},
+		{args: "connect cluster describe az-connector --connect-url " + connectUrl, fixture: "connect/cluster/onprem/describe.golden"},
+		{args: "connect cluster describe az-connector --connect-url " + connectUrl + " -o json", fixture: "connect/cluster/onprem/describe-json.golden"},
+		{args: "connect cluster describe unknown --connect-url " + connectUrl, fixture: "connect/cluster/onprem/describe-unknown.golden", wantErrCode: 1},
+		{args: "connect cluster describe az-connector --cluster-name unknown", fixture: "connect/cluster/onprem/describe-unknown-cluster.golden", wantErrCode: 1},
+		{args: "connect cluster update az-connector --config tasks.max=2 --connect-url " + connectUrl, fixture: "connect/cluster/onprem/update.golden"},
+		{args: "connect cluster pause az-connector --connect-url " + connectUrl, fixture: "connect/cluster/onprem/pause.golden"},
+		{args: "connect cluster resume az-connector --connect-url " + connectUrl, fixture: "connect/cluster/onprem/resume.golden"},
+		{args: "connect cluster restart az-connector --connect-url " + connectUrl, fixture: "connect/cluster/onprem/restart.golden"},
+		{args: "connect cluster restart az-connector --task 0 --connect-url " + connectUrl, fixture: "connect/cluster/onprem/restart-task.golden"},
+		{args: "connect cluster restart az-connector --task 5 --connect-url " + connectUrl, fixture: "connect/cluster/onprem/restart-task-unknown.golden", wantErrCode: 1},
+		{args: "connect cluster delete az-connector --force --connect-url " + connectUrl, fixture: "connect/cluster/onprem/delete.golden"},
+		{args: "connect cluster delete az-connector --connect-url " + connectUrl, preCmdFuncs: []bincover.PreCmdFunc{stdinPipeFunc(strings.NewReader("y\n"))}, fixture: "connect/cluster/onprem/delete-prompt.golden"},
+	}
+
+	for _, test := range tests {
+		test.login = "platform"
+		s.runIntegrationTest(test)
+	}
+}
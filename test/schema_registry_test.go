@@ -83,6 +83,11 @@ func (s *CLITestSuite) TestSchemaRegistry() {
 		{args: "schema-registry exporter resume --help", fixture: "schema-registry/exporter/resume-help.golden"},
 		{args: "schema-registry exporter update --help", fixture: "schema-registry/exporter/update-help.golden"},
 		{args: "schema-registry cluster update --help", fixture: "schema-registry/cluster/update-help.golden"},
+		{args: "schema-registry config --help", fixture: "schema-registry/config/help.golden"},
+		{args: "schema-registry config update --help", fixture: "schema-registry/config/update-help.golden"},
+		{args: "schema-registry context --help", fixture: "schema-registry/context/help.golden"},
+		{args: "schema-registry mode --help", fixture: "schema-registry/mode/help.golden"},
+		{args: "schema-registry mode set --help", fixture: "schema-registry/mode/set-help.golden"},
 		{args: "schema-registry subject update --help", fixture: "schema-registry/subject/update-help.golden"},
 
 		{args: "schema-registry cluster describe", fixture: "schema-registry/cluster/describe.golden"},
@@ -140,6 +145,81 @@ func (s *CLITestSuite) TestSchemaRegistry() {
 			args:    fmt.Sprintf(`schema-registry config describe --subject payments --api-key key --api-secret secret --environment %s`, testserver.SRApiEnvId),
 			fixture: "schema-registry/config/describe-subject.golden",
 		},
+		{
+			name:    "schema-registry config update global",
+			args:    fmt.Sprintf(`schema-registry config update --compatibility backward --api-key key --api-secret secret --environment %s`, testserver.SRApiEnvId),
+			fixture: "schema-registry/config/update-global.golden",
+		},
+		{
+			name:    "schema-registry config update --subject payments",
+			args:    fmt.Sprintf(`schema-registry config update --subject payments --normalize --compatibility-group application.major.version --api-key key --api-secret secret --environment %s`, testserver.SRApiEnvId),
+			fixture: "schema-registry/config/update-subject.golden",
+		},
+		{
+			name:        "schema-registry config update without flags",
+			args:        fmt.Sprintf(`schema-registry config update --api-key key --api-secret secret --environment %s`, testserver.SRApiEnvId),
+			fixture:     "schema-registry/config/update-missing-flags.golden",
+			wantErrCode: 1,
+		},
+		{
+			name:        "schema-registry config update invalid compatibility",
+			args:        fmt.Sprintf(`schema-registry config update --compatibility sideways --api-key key --api-secret secret --environment %s`, testserver.SRApiEnvId),
+			fixture:     "schema-registry/config/update-invalid-compatibility.golden",
+			wantErrCode: 1,
+		},
+		{
+			name:    "schema-registry config delete --subject payments",
+			args:    fmt.Sprintf(`schema-registry config delete --subject payments --force --api-key key --api-secret secret --environment %s`, testserver.SRApiEnvId),
+			fixture: "schema-registry/config/delete.golden",
+		},
+		{
+			name:        "schema-registry config delete --subject orders",
+			args:        fmt.Sprintf(`schema-registry config delete --subject orders --force --api-key key --api-secret secret --environment %s`, testserver.SRApiEnvId),
+			fixture:     "schema-registry/config/delete-not-configured.golden",
+			wantErrCode: 1,
+		},
+		{
+			name:    "schema-registry context list",
+			args:    fmt.Sprintf(`schema-registry context list --api-key key --api-secret secret --environment %s`, testserver.SRApiEnvId),
+			fixture: "schema-registry/context/list.golden",
+		},
+		{
+			name:    "schema-registry context list json",
+			args:    fmt.Sprintf(`schema-registry context list --api-key key --api-secret secret --environment %s -o json`, testserver.SRApiEnvId),
+			fixture: "schema-registry/context/list-json.golden",
+		},
+		{
+			name:    "schema-registry mode get global",
+			args:    fmt.Sprintf(`schema-registry mode get --api-key key --api-secret secret --environment %s`, testserver.SRApiEnvId),
+			fixture: "schema-registry/mode/get-global.golden",
+		},
+		{
+			name:    "schema-registry mode get --subject testSubject",
+			args:    fmt.Sprintf(`schema-registry mode get --subject testSubject --api-key key --api-secret secret --environment %s -o json`, testserver.SRApiEnvId),
+			fixture: "schema-registry/mode/get-subject-json.golden",
+		},
+		{
+			name:        "schema-registry mode get --subject payments",
+			args:        fmt.Sprintf(`schema-registry mode get --subject payments --api-key key --api-secret secret --environment %s`, testserver.SRApiEnvId),
+			fixture:     "schema-registry/mode/get-subject-not-configured.golden",
+			wantErrCode: 1,
+		},
+		{
+			name:    "schema-registry mode set global",
+			args:    fmt.Sprintf(`schema-registry mode set IMPORT --api-key key --api-secret secret --environment %s`, testserver.SRApiEnvId),
+			fixture: "schema-registry/mode/set-global.golden",
+		},
+		{
+			name:    "schema-registry mode set --subject testSubject",
+			args:    fmt.Sprintf(`schema-registry mode set readonly --subject testSubject --api-key key --api-secret secret --environment %s`, testserver.SRApiEnvId),
+			fixture: "schema-registry/mode/set-subject.golden",
+		},
+		{
+			name:        "schema-registry mode set invalid",
+			args:        fmt.Sprintf(`schema-registry mode set foo --api-key key --api-secret secret --environment %s`, testserver.SRApiEnvId),
+			fixture:     "schema-registry/mode/set-invalid.golden",
+			wantErrCode: 1,
+		},
 		{
 			name:    "schema-registry schema delete latest",
 			args:    fmt.Sprintf(`schema-registry schema delete --subject payments --version latest --api-key key --api-secret secret --environment %s --force`, testserver.SRApiEnvId),
@@ -23,6 +23,7 @@ type TestBackend struct {
 	kafkaRestProxy *httptest.Server
 	mds            *httptest.Server
 	sr             *httptest.Server
+	connect        *httptest.Server
 }
 
 func StartTestBackend(t *testing.T, isAuditLogEnabled bool) *TestBackend {
@@ -31,6 +32,7 @@ func StartTestBackend(t *testing.T, isAuditLogEnabled bool) *TestBackend {
 	kafkaRestProxyRouter := NewKafkaRestProxyRouter(t)
 	mdsRouter := NewMdsRouter(t)
 	srRouter := NewSRRouter(t)
+	connectRouter := NewConnectRouter(t)
 
 	backend := &TestBackend{
 		cloud:          newTestCloudServer(cloudRouter, TestCloudUrl.Host),
@@ -38,6 +40,7 @@ func StartTestBackend(t *testing.T, isAuditLogEnabled bool) *TestBackend {
 		kafkaRestProxy: newTestCloudServer(kafkaRestProxyRouter, TestKafkaRestProxyUrl.Host),
 		mds:            httptest.NewServer(mdsRouter),
 		sr:             httptest.NewServer(srRouter),
+		connect:        httptest.NewServer(connectRouter),
 	}
 
 	cloudRouter.srApiUrl = backend.sr.URL
@@ -87,6 +90,9 @@ func (b *TestBackend) Close() {
 	if b.sr != nil {
 		b.sr.Close()
 	}
+	if b.connect != nil {
+		b.connect.Close()
+	}
 }
 
 func (b *TestBackend) GetCloudUrl() string {
@@ -100,3 +106,7 @@ func (b *TestBackend) GetKafkaRestUrl() string {
 func (b *TestBackend) GetMdsUrl() string {
 	return b.mds.URL
 }
+
+func (b *TestBackend) GetConnectUrl() string {
+	return b.connect.URL
+}
@@ -19,13 +19,15 @@ var ccloudV2Handlers = map[string]func(*testing.T) http.HandlerFunc{
 	"/cdx/v1/shared-tokens:redeem":                   handleStreamSharingRedeemToken,
 	"/cmk/v2/clusters":                               handleCmkClusters,
 	"/cmk/v2/clusters/{id}":                          handleCmkCluster,
-	"/connect/v1/environments/{env}/clusters/{clusters}/connector-plugins":                          handlePlugins,
-	"/connect/v1/environments/{env}/clusters/{clusters}/connector-plugins/{plugin}/config/validate": handlePluginValidate,
-	"/connect/v1/environments/{env}/clusters/{clusters}/connectors":                                 handleConnectors,
-	"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}":                     handleConnector,
-	"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/config":              handleConnectorConfig,
-	"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/pause":               handleConnectorPause,
-	"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/resume":              handleConnectorResume,
+	"/connect/v1/environments/{env}/clusters/{clusters}/connector-plugins":                           handlePlugins,
+	"/connect/v1/environments/{env}/clusters/{clusters}/connector-plugins/{plugin}/config/validate":  handlePluginValidate,
+	"/connect/v1/environments/{env}/clusters/{clusters}/connectors":                                  handleConnectors,
+	"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}":                      handleConnector,
+	"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/config":               handleConnectorConfig,
+	"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/pause":                handleConnectorPause,
+	"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/resume":               handleConnectorResume,
+	"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/restart":              handleConnectorRestart,
+	"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/tasks/{task}/restart": handleConnectorTaskRestart,
 	"/iam/v2/api-keys":                                             handleIamApiKeys,
 	"/iam/v2/api-keys/{id}":                                        handleIamApiKey,
 	"/iam/v2/identity-providers":                                   handleIamIdentityProviders,
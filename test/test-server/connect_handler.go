@@ -2,11 +2,17 @@ package testserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
-	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/require"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+
+	"github.com/confluentinc/cli/internal/pkg/utils"
 )
 
 // Handler for: "/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}"
@@ -54,6 +60,25 @@ func handleConnectorResume(t *testing.T) http.HandlerFunc {
 	}
 }
 
+// Handler for: "/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/restart"
+func handleConnectorRestart(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "true", r.URL.Query().Get("includeTasks"))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// Handler for: "/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/tasks/{task}/restart"
+func handleConnectorTaskRestart(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.Equal(t, http.MethodPost, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
 // Handler for: "/connect/v1/environments/{env}/clusters/{clusters}/connectors"
 func handleConnectors(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -81,6 +106,9 @@ func handleConnectors(t *testing.T) http.HandlerFunc {
 			var request connectv1.InlineObject
 			err := json.NewDecoder(r.Body).Decode(&request)
 			require.NoError(t, err)
+			for _, value := range request.GetConfig() {
+				require.NotRegexp(t, `\$\{[A-Z_]+}|\$\{(env|file|context):`, value)
+			}
 			connector := &connectv1.ConnectV1Connector{
 				Name:   *request.Name,
 				Config: *request.Config,
@@ -114,7 +142,11 @@ func handlePlugins(t *testing.T) http.HandlerFunc {
 func handlePluginValidate(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
-		configs := &[]connectv1.InlineResponse2003Configs{
+		var request map[string]string
+		err := json.NewDecoder(r.Body).Decode(&request)
+		require.NoError(t, err)
+
+		configs := []connectv1.InlineResponse2003Configs{
 			connectv1.InlineResponse2003Configs{
 				Value: &connectv1.InlineResponse2003Value{
 					Name:   connectv1.PtrString("kafka.api.key"),
@@ -140,8 +172,9 @@ func handlePluginValidate(t *testing.T) http.HandlerFunc {
 					Required:      connectv1.PtrBool(true)}},
 			connectv1.InlineResponse2003Configs{
 				Value: &connectv1.InlineResponse2003Value{
-					Name:   connectv1.PtrString("data.format"),
-					Errors: &[]string{`"data.format" is required, Value "null" doesn't belong to the property's "data.format" enum`}},
+					Name:              connectv1.PtrString("data.format"),
+					Errors:            &[]string{`"data.format" is required, Value "null" doesn't belong to the property's "data.format" enum`},
+					RecommendedValues: &[]string{"AVRO", "BYTES", "JSON"}},
 				Definition: &connectv1.InlineResponse2003Definition{
 					Documentation: connectv1.PtrString("Sets the input value format."),
 					Required:      connectv1.PtrBool(true)}},
@@ -181,7 +214,25 @@ func handlePluginValidate(t *testing.T) http.HandlerFunc {
 					Required:      connectv1.PtrBool(false)}},
 		}
 
-		err := json.NewEncoder(w).Encode(connectv1.InlineResponse2003{Configs: configs})
+		var errorCount int32
+		validatedConfigs := &[]connectv1.InlineResponse2003Configs{}
+		for _, config := range configs {
+			name := config.Value.GetName()
+			if strings.HasPrefix(name, "gcs.") && mux.Vars(r)["plugin"] != "GcsSink" {
+				continue
+			}
+			if value, ok := request[name]; ok {
+				config.Value.Value = connectv1.PtrString(value)
+				config.Value.Errors = nil
+				if recommendedValues := config.Value.GetRecommendedValues(); len(recommendedValues) > 0 && !utils.Contains(recommendedValues, value) {
+					config.Value.Errors = &[]string{fmt.Sprintf(`Value "%s" doesn't belong to the property's "%s" enum`, value, name)}
+				}
+			}
+			errorCount += int32(len(config.Value.GetErrors()))
+			*validatedConfigs = append(*validatedConfigs, config)
+		}
+
+		err = json.NewEncoder(w).Encode(connectv1.InlineResponse2003{Configs: validatedConfigs, ErrorCount: connectv1.PtrInt32(errorCount)})
 		require.NoError(t, err)
 	}
 }
@@ -0,0 +1,204 @@
+package testserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+)
+
+// Connect REST API URLs
+const (
+	connectors           = "/connectors"
+	connector            = "/connectors/{connector}"
+	connectorConfig      = "/connectors/{connector}/config"
+	connectorStatus      = "/connectors/{connector}/status"
+	connectorPause       = "/connectors/{connector}/pause"
+	connectorResume      = "/connectors/{connector}/resume"
+	connectorRestart     = "/connectors/{connector}/restart"
+	connectorTaskRestart = "/connectors/{connector}/tasks/{task}/restart"
+)
+
+const (
+	onPremConnectorName   = "az-connector"
+	onPremConnectorWorker = "connect-0:8083"
+
+	// onPremConfigProviderPlaceholder is resolved by the FileConfigProvider of the worker, so it must reach the worker as is.
+	onPremConfigProviderPlaceholder = "${file:/etc/kafka/secrets.properties:password}"
+)
+
+type ConnectRouter struct {
+	*mux.Router
+}
+
+func NewConnectRouter(t *testing.T) *ConnectRouter {
+	router := &ConnectRouter{mux.NewRouter()}
+	router.Use(requireBearerToken(t))
+	router.HandleFunc(connectors, handleConnectorsOnPrem(t))
+	router.HandleFunc(connector, handleConnectorOnPrem(t))
+	router.HandleFunc(connectorConfig, handleConnectorConfigOnPrem(t))
+	router.HandleFunc(connectorStatus, handleConnectorStatusOnPrem(t))
+	router.HandleFunc(connectorPause, handleConnectorPauseOrResumeOnPrem(t))
+	router.HandleFunc(connectorResume, handleConnectorPauseOrResumeOnPrem(t))
+	router.HandleFunc(connectorRestart, handleConnectorRestartOnPrem(t))
+	router.HandleFunc(connectorTaskRestart, handleConnectorTaskRestartOnPrem(t))
+	return router
+}
+
+// requireBearerToken checks that every request to the Connect REST API is authenticated with the MDS token.
+func requireBearerToken(t *testing.T) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "Bearer "))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func onPremConnectorInfo(name string) *connectv1.ConnectV1ConnectorExpansionInfo {
+	return &connectv1.ConnectV1ConnectorExpansionInfo{
+		Name: connectv1.PtrString(name),
+		Config: &map[string]string{
+			"name":            name,
+			"connector.class": "AzureBlobSink",
+			"tasks.max":       "1",
+			"topics":          "orders",
+		},
+	}
+}
+
+func writeConnectorNotFoundError(w http.ResponseWriter, name string) error {
+	w.WriteHeader(http.StatusNotFound)
+	return json.NewEncoder(w).Encode(map[string]interface{}{
+		"error_code": http.StatusNotFound,
+		"message":    fmt.Sprintf("Connector %s not found", name),
+	})
+}
+
+// Handler for: "/connectors"
+func handleConnectorsOnPrem(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body := new(struct {
+				Name   string            `json:"name"`
+				Config map[string]string `json:"config"`
+			})
+			require.NoError(t, json.NewDecoder(r.Body).Decode(body))
+			if password, ok := body.Config["connection.password"]; ok {
+				require.Equal(t, onPremConfigProviderPlaceholder, password)
+			}
+			w.WriteHeader(http.StatusCreated)
+			err := json.NewEncoder(w).Encode(&connectv1.ConnectV1ConnectorExpansionInfo{
+				Name:   connectv1.PtrString(body.Name),
+				Config: &body.Config,
+			})
+			require.NoError(t, err)
+		}
+	}
+}
+
+// Handler for: "/connectors/{connector}"
+func handleConnectorOnPrem(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["connector"]
+		if name != onPremConnectorName {
+			require.NoError(t, writeConnectorNotFoundError(w, name))
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			err := json.NewEncoder(w).Encode(onPremConnectorInfo(name))
+			require.NoError(t, err)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+}
+
+// Handler for: "/connectors/{connector}/config"
+func handleConnectorConfigOnPrem(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["connector"]
+		if r.Method == http.MethodPut {
+			var configs map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&configs))
+			err := json.NewEncoder(w).Encode(&connectv1.ConnectV1ConnectorExpansionInfo{
+				Name:   connectv1.PtrString(name),
+				Config: &configs,
+			})
+			require.NoError(t, err)
+		}
+	}
+}
+
+// Handler for: "/connectors/{connector}/status"
+func handleConnectorStatusOnPrem(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["connector"]
+		if name != onPremConnectorName {
+			require.NoError(t, writeConnectorNotFoundError(w, name))
+			return
+		}
+
+		err := json.NewEncoder(w).Encode(&connectv1.ConnectV1ConnectorExpansionStatus{
+			Name:      name,
+			Type:      "sink",
+			Connector: connectv1.ConnectV1ConnectorExpansionStatusConnector{State: "RUNNING", WorkerId: onPremConnectorWorker},
+			Tasks:     &[]connectv1.ConnectV1ConnectorExpansionStatusTasks{{Id: 0, State: "RUNNING", WorkerId: onPremConnectorWorker}},
+		})
+		require.NoError(t, err)
+	}
+}
+
+// Handler for: "/connectors/{connector}/pause" and "/connectors/{connector}/resume"
+func handleConnectorPauseOrResumeOnPrem(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["connector"]
+		if name != onPremConnectorName {
+			require.NoError(t, writeConnectorNotFoundError(w, name))
+			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+// Handler for: "/connectors/{connector}/restart"
+func handleConnectorRestartOnPrem(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["connector"]
+		if name != onPremConnectorName {
+			require.NoError(t, writeConnectorNotFoundError(w, name))
+			return
+		}
+
+		require.Equal(t, "true", r.URL.Query().Get("includeTasks"))
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+// Handler for: "/connectors/{connector}/tasks/{task}/restart"
+func handleConnectorTaskRestartOnPrem(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["connector"]
+		task := mux.Vars(r)["task"]
+		if name != onPremConnectorName || task != "0" {
+			w.WriteHeader(http.StatusNotFound)
+			err := json.NewEncoder(w).Encode(map[string]interface{}{
+				"error_code": http.StatusNotFound,
+				"message":    fmt.Sprintf("Unknown task: %s-%s", name, task),
+			})
+			require.NoError(t, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -529,7 +529,22 @@ func handleKafkaRPTopic(t *testing.T) http.HandlerFunc {
 				// topic not found
 				require.NoError(t, writeErrorResponse(w, http.StatusNotFound, 40403, "This server does not host this topic-partition."))
 			}
-
+		case http.MethodPatch:
+			if vars["topic"] != "topic-exist" && vars["topic"] != "topic-exist-rest" {
+				require.NoError(t, writeErrorResponse(w, http.StatusNotFound, 40403, "This server does not host this topic-partition."))
+				return
+			}
+			var req struct {
+				PartitionsCount int32 `json:"partitions_count"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			w.Header().Set("Content-Type", "application/json")
+			err := json.NewEncoder(w).Encode(cckafkarestv3.TopicData{
+				ClusterId:       vars["cluster"],
+				TopicName:       vars["topic"],
+				PartitionsCount: req.PartitionsCount,
+			})
+			require.NoError(t, err)
 		}
 	}
 }
@@ -26,10 +26,10 @@ func (s *SRRouter) HandleSRUpdateTopLevelConfig(t *testing.T) http.HandlerFunc {
 		w.Header().Set("Content-Type", "application/json")
 		switch r.Method {
 		case http.MethodPut:
-			var req srsdk.ConfigUpdateRequest
+			var req map[string]interface{}
 			err := json.NewDecoder(r.Body).Decode(&req)
 			require.NoError(t, err)
-			err = json.NewEncoder(w).Encode(srsdk.ConfigUpdateRequest{Compatibility: req.Compatibility})
+			err = json.NewEncoder(w).Encode(req)
 			require.NoError(t, err)
 		case http.MethodGet:
 			res := srsdk.Config{CompatibilityLevel: "FULL"}
@@ -42,12 +42,18 @@ func (s *SRRouter) HandleSRUpdateTopLevelConfig(t *testing.T) http.HandlerFunc {
 // Handler for: "/mode"
 func (s *SRRouter) HandleSRUpdateTopLevelMode(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var req srsdk.ModeUpdateRequest
-		err := json.NewDecoder(r.Body).Decode(&req)
-		require.NoError(t, err)
 		w.Header().Set("Content-Type", "application/json")
-		err = json.NewEncoder(w).Encode(srsdk.ModeUpdateRequest{Mode: req.Mode})
-		require.NoError(t, err)
+		switch r.Method {
+		case http.MethodPut:
+			var req srsdk.ModeUpdateRequest
+			err := json.NewDecoder(r.Body).Decode(&req)
+			require.NoError(t, err)
+			err = json.NewEncoder(w).Encode(srsdk.ModeUpdateRequest{Mode: req.Mode})
+			require.NoError(t, err)
+		case http.MethodGet:
+			err := json.NewEncoder(w).Encode(srsdk.Mode{Mode: "READWRITE"})
+			require.NoError(t, err)
+		}
 	}
 }
 
@@ -375,15 +381,24 @@ func (s *SRRouter) HandleSRSubjectConfig(t *testing.T) http.HandlerFunc {
 		w.Header().Set("Content-Type", "application/json")
 		switch r.Method {
 		case http.MethodPut:
-			var req srsdk.ConfigUpdateRequest
+			var req map[string]interface{}
 			err := json.NewDecoder(r.Body).Decode(&req)
 			require.NoError(t, err)
-			err = json.NewEncoder(w).Encode(srsdk.ConfigUpdateRequest{Compatibility: req.Compatibility})
+			err = json.NewEncoder(w).Encode(req)
 			require.NoError(t, err)
 		case http.MethodGet:
 			res := srsdk.Config{CompatibilityLevel: "FORWARD"}
 			err := json.NewEncoder(w).Encode(res)
 			require.NoError(t, err)
+		case http.MethodDelete:
+			if mux.Vars(r)["subject"] != "payments" {
+				w.WriteHeader(http.StatusNotFound)
+				err := json.NewEncoder(w).Encode(map[string]interface{}{"error_code": 40408, "message": "Subject does not have subject-level compatibility configured"})
+				require.NoError(t, err)
+				return
+			}
+			err := json.NewEncoder(w).Encode(srsdk.Config{CompatibilityLevel: "FORWARD"})
+			require.NoError(t, err)
 		}
 	}
 }
@@ -392,10 +407,31 @@ func (s *SRRouter) HandleSRSubjectConfig(t *testing.T) http.HandlerFunc {
 func (s *SRRouter) HandleSRSubjectMode(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
-		var req srsdk.ModeUpdateRequest
-		err := json.NewDecoder(r.Body).Decode(&req)
-		require.NoError(t, err)
-		err = json.NewEncoder(w).Encode(srsdk.ModeUpdateRequest{Mode: req.Mode})
+		switch r.Method {
+		case http.MethodPut:
+			var req srsdk.ModeUpdateRequest
+			err := json.NewDecoder(r.Body).Decode(&req)
+			require.NoError(t, err)
+			err = json.NewEncoder(w).Encode(srsdk.ModeUpdateRequest{Mode: req.Mode})
+			require.NoError(t, err)
+		case http.MethodGet:
+			if mux.Vars(r)["subject"] != "testSubject" {
+				w.WriteHeader(http.StatusNotFound)
+				err := json.NewEncoder(w).Encode(map[string]interface{}{"error_code": 40409, "message": "Subject not found"})
+				require.NoError(t, err)
+				return
+			}
+			err := json.NewEncoder(w).Encode(srsdk.Mode{Mode: "READONLY"})
+			require.NoError(t, err)
+		}
+	}
+}
+
+// Handler for: "/contexts"
+func (s *SRRouter) HandleSRContexts(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode([]string{".", ":.staging:"})
 		require.NoError(t, err)
 	}
 }
@@ -27,6 +27,7 @@ const (
 	exporterReset        = "/exporters/{name}/reset"
 	subjectLevelConfig   = "/config/{subject}"
 	modeSubject          = "/mode/{subject}"
+	contexts             = "/contexts"
 	asyncApi             = "/asyncapi"
 )
 
@@ -66,5 +67,6 @@ func (s *SRRouter) buildSRHandler(t *testing.T) {
 	s.HandleFunc(exporterReset, s.HandleSRExporterReset(t))
 	s.HandleFunc(subjectLevelConfig, s.HandleSRSubjectConfig(t))
 	s.HandleFunc(modeSubject, s.HandleSRSubjectMode(t))
+	s.HandleFunc(contexts, s.HandleSRContexts(t))
 	s.HandleFunc(asyncApi, s.HandleSRAsyncApi(t))
 }